@@ -0,0 +1,244 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+type (
+	// BreakerState is the state of a Breaker.
+	BreakerState int
+	// Breaker is a circuit breaker that stops calls to a
+	// failing dependency. It opens after Failures consecutive
+	// failures, or once the share of failed calls within the
+	// Window reaches the FailureRate, and rejects calls for
+	// OpenFor. It is then half-open and lets a single probe
+	// through, which closes the breaker if it succeeds and
+	// opens it again if it fails.
+	//
+	// A Breaker is safe for concurrent use.
+	Breaker struct {
+		// Failures is the number of consecutive failures that
+		// open the breaker, zero to disable.
+		Failures int
+		// FailureRate is the share of failed calls, between 0
+		// and 1, within the Window that opens the breaker once
+		// there have been MinRequests calls, zero to disable.
+		FailureRate float64
+		MinRequests int
+		// Window is the period calls are counted over for the
+		// FailureRate, zero to count every call since the
+		// breaker last closed.
+		Window time.Duration
+		// OpenFor is the time the breaker rejects calls for
+		// once opened, and the time a probe is given before
+		// another is let through.
+		OpenFor time.Duration
+		// OnChange, if set, is called with every change of
+		// state, such as to alert or log when the breaker
+		// opens.
+		OnChange  func(from, to BreakerState)
+		mtx       sync.Mutex
+		state     BreakerState
+		failures  int
+		calls     int
+		failed    int
+		window    time.Time
+		changedAt time.Time
+		now       func() time.Time
+	}
+	// BreakerError is returned by lookups rejected because the
+	// Client.Breaker is open, it matches ErrBreakerOpen.
+	BreakerError struct {
+		// Until is when the breaker lets a probe through.
+		Until time.Time
+	}
+)
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects every call.
+	BreakerOpen
+	// BreakerHalfOpen lets a single probe through.
+	BreakerHalfOpen
+)
+
+var (
+	// ErrBreakerOpen is matched by the BreakerError of lookups
+	// rejected by the Client.Breaker, use errors.Is.
+	ErrBreakerOpen = errors.New("luminati breaker open")
+)
+
+// String implements fmt.Stringer.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// Error implements the error interface.
+func (e *BreakerError) Error() string {
+	return ErrBreakerOpen.Error() + " until " + e.Until.Format(time.RFC3339)
+}
+
+// Is returns true for ErrBreakerOpen.
+func (e *BreakerError) Is(target error) bool {
+	return target == ErrBreakerOpen
+}
+
+// NewBreaker creates a closed Breaker opening after the
+// number of consecutive failures.
+func NewBreaker(failures int, openFor time.Duration) *Breaker {
+	return &Breaker{
+		Failures: failures,
+		OpenFor:  openFor,
+		now:      time.Now,
+	}
+}
+
+// Allow returns true if a call may be made, which must then
+// be reported with Success or Failure.
+func (b *Breaker) Allow() bool {
+	b.mtx.Lock()
+	from := b.state
+
+	allow := true
+	if b.state != BreakerClosed {
+		allow = b.since() >= b.OpenFor
+		if allow {
+			// Let a probe through, or another if the last
+			// was never reported.
+			b.set(BreakerHalfOpen)
+		}
+	}
+
+	to := b.state
+	b.mtx.Unlock()
+	b.notify(from, to)
+
+	return allow
+}
+
+// Success reports a successful call, closing the breaker.
+func (b *Breaker) Success() {
+	b.mtx.Lock()
+	from := b.state
+
+	b.failures = 0
+	if b.state != BreakerClosed {
+		b.set(BreakerClosed)
+	} else {
+		b.count(false)
+	}
+
+	to := b.state
+	b.mtx.Unlock()
+	b.notify(from, to)
+}
+
+// Failure reports a failed call, opening the breaker if the
+// probe failed or a threshold has been reached.
+func (b *Breaker) Failure() {
+	b.mtx.Lock()
+	from := b.state
+
+	b.failures++
+	if b.state == BreakerHalfOpen || b.tripped() {
+		b.set(BreakerOpen)
+	}
+
+	to := b.state
+	b.mtx.Unlock()
+	b.notify(from, to)
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.state
+}
+
+// OpenUntil returns when the breaker lets the next probe
+// through, zero if it is closed.
+func (b *Breaker) OpenUntil() time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.state == BreakerClosed {
+		return time.Time{}
+	}
+	return b.changedAt.Add(b.OpenFor)
+}
+
+// tripped counts a failed call while closed and returns true
+// if a threshold has been reached.
+func (b *Breaker) tripped() bool {
+	if b.state != BreakerClosed {
+		return false
+	}
+	b.count(true)
+	if b.Failures > 0 && b.failures >= b.Failures {
+		return true
+	}
+	return b.FailureRate > 0 && b.calls >= b.MinRequests &&
+		float64(b.failed)/float64(b.calls) >= b.FailureRate
+}
+
+// count adds a call to the window, starting a new window if
+// the current one has passed.
+func (b *Breaker) count(failed bool) {
+	if b.Window > 0 && b.time().Sub(b.window) >= b.Window {
+		b.reset()
+	}
+	b.calls++
+	if failed {
+		b.failed++
+	}
+}
+
+// reset starts a new window of calls.
+func (b *Breaker) reset() {
+	b.calls = 0
+	b.failed = 0
+	b.window = b.time()
+}
+
+// set changes the state of the breaker.
+func (b *Breaker) set(s BreakerState) {
+	b.state = s
+	b.changedAt = b.time()
+	b.failures = 0
+	b.reset()
+}
+
+// notify calls OnChange if the state changed, outside of the
+// lock so it may use the breaker.
+func (b *Breaker) notify(from, to BreakerState) {
+	if b.OnChange != nil && from != to {
+		b.OnChange(from, to)
+	}
+}
+
+// since returns the time since the state last changed.
+func (b *Breaker) since() time.Duration {
+	return b.time().Sub(b.changedAt)
+}
+
+// time returns the current time, allowing a Breaker to be
+// declared without NewBreaker.
+func (b *Breaker) time() time.Time {
+	if b.now == nil {
+		return time.Now()
+	}
+	return b.now()
+}
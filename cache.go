@@ -0,0 +1,236 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ainsleyclark/redigo"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+const (
+	// DefaultCacheTimeout is the time limit of each cache
+	// operation, see Client.CacheTimeout.
+	DefaultCacheTimeout = 2 * time.Second
+	// DefaultCacheBreakerFailures is the number of cache
+	// failures in a row that open the cache breaker.
+	DefaultCacheBreakerFailures = 5
+	// DefaultCacheBreakerOpen is the time the cache is skipped
+	// for once the cache breaker opens.
+	DefaultCacheBreakerOpen = 30 * time.Second
+)
+
+var (
+	// ErrCacheMiss may be returned by a redigo.Store when a
+	// key is not found, redis.Nil is also a miss.
+	ErrCacheMiss = errors.New("luminati cache miss")
+	// ErrCacheOpen is the error of cache operations skipped
+	// because the cache breaker is open.
+	ErrCacheOpen = errors.New("luminati cache breaker open")
+)
+
+// CacheError is a failed cache operation. Cache misses are
+// not errors.
+type CacheError struct {
+	// Op is either "get" or "set".
+	Op  string
+	Key string
+	Err error
+}
+
+// Error implements the error interface.
+func (e *CacheError) Error() string {
+	if e.Err == nil {
+		return "luminati cache " + e.Op + " failed"
+	}
+	return "luminati cache " + e.Op + " failed: " + e.Err.Error()
+}
+
+// Unwrap returns the error of the cache.
+func (e *CacheError) Unwrap() error {
+	return e.Err
+}
+
+// cacheErrorJSON is the JSON encoding of a CacheError, with
+// the error stored as its message.
+type cacheErrorJSON struct {
+	Op  string
+	Key string
+	Err string `json:",omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding the error
+// as its message.
+func (e CacheError) MarshalJSON() ([]byte, error) {
+	v := cacheErrorJSON{Op: e.Op, Key: e.Key}
+	if e.Err != nil {
+		v.Err = e.Err.Error()
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The error is
+// ErrCacheOpen if the message matches, otherwise an error
+// with the message.
+func (e *CacheError) UnmarshalJSON(buf []byte) error {
+	v := cacheErrorJSON{}
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return err
+	}
+	*e = CacheError{Op: v.Op, Key: v.Key}
+	switch v.Err {
+	case "":
+	case ErrCacheOpen.Error():
+		e.Err = ErrCacheOpen
+	default:
+		e.Err = errors.New(v.Err)
+	}
+	return nil
+}
+
+// IsCacheMiss returns true if the error returned by a cache
+// get means the key was not found, rather than the cache
+// failing.
+func IsCacheMiss(err error) bool {
+	return errors.Is(err, redis.Nil) || errors.Is(err, ErrCacheMiss)
+}
+
+// Ping checks the cache is reachable within the CacheTimeout,
+// such as for a readiness probe. Nil is returned if the
+// client has no cache.
+func (c *Client) Ping(ctx context.Context) error {
+	if !c.HasCache {
+		return nil
+	}
+	ctx, cancel := c.cacheContext(ctx)
+	defer cancel()
+	if err := c.cache.Ping(ctx); err != nil {
+		return errors.Wrap(err, "error pinging luminati cache")
+	}
+	return nil
+}
+
+// cacheGet retrieves the key from the cache within a span,
+// returning true if it was found. Failures are treated as a
+// miss and added to the meta.
+func (c *Client) cacheGet(ctx context.Context, o Options, meta *Meta, key string, v interface{}) bool {
+	if c.CacheBreaker != nil && !c.CacheBreaker.Allow() {
+		c.cacheFailed(ctx, o, meta, "get", key, ErrCacheOpen)
+		c.observeCache(o, false)
+		return false
+	}
+
+	lookup := trace.SpanFromContext(ctx)
+	ctx, span := c.startSpan(ctx, "luminati.cache.get", attrCacheKey.String(key))
+	defer span.End()
+
+	cctx, cancel := c.cacheContext(ctx)
+	defer cancel()
+
+	err := c.cache.Get(cctx, key, v)
+	hit := err == nil
+	span.SetAttributes(attrCacheHit.Bool(hit))
+	lookup.SetAttributes(attrCacheHit.Bool(hit))
+	c.observeCache(o, hit)
+
+	switch {
+	case hit:
+		c.cacheSucceeded()
+		c.log(ctx, c.LogLevels.Cache, "luminati cache hit", slog.String("key", key))
+	case IsCacheMiss(err):
+		c.cacheSucceeded()
+		c.log(ctx, c.LogLevels.Cache, "luminati cache miss", slog.String("key", key))
+	default:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.cacheFailed(ctx, o, meta, "get", key, err)
+	}
+
+	return hit
+}
+
+// cacheSet stores the lookup in the cache, along with a
+// stale copy if StaleExpiry is set.
+func (c *Client) cacheSet(ctx context.Context, o Options, meta *Meta, v interface{}) {
+	c.cacheSetKey(ctx, o, meta, meta.CacheKey, v, c.CacheExpiry)
+	if c.StaleExpiry > 0 {
+		c.cacheSetKey(ctx, o, meta, staleKey(meta.CacheKey), v, c.StaleExpiry)
+	}
+}
+
+// cacheSetKey stores the key in the cache within a span,
+// adding any failure to the meta. The write is not cancelled
+// with the context, so a result is still stored if the
+// caller gives up once it is returned, but it is limited by
+// the CacheTimeout.
+func (c *Client) cacheSetKey(ctx context.Context, o Options, meta *Meta, key string, v interface{}, expiry time.Duration) {
+	if c.CacheBreaker != nil && !c.CacheBreaker.Allow() {
+		c.cacheFailed(ctx, o, meta, "set", key, ErrCacheOpen)
+		return
+	}
+
+	ctx, span := c.startSpan(context.WithoutCancel(ctx), "luminati.cache.set", attrCacheKey.String(key))
+	cctx, cancel := c.cacheContext(ctx)
+	defer cancel()
+
+	err := c.cache.Set(cctx, key, v, redigo.Options{
+		Expiration: expiry,
+	})
+	endSpan(span, err)
+	if err != nil {
+		c.cacheFailed(ctx, o, meta, "set", key, err)
+		return
+	}
+	c.cacheSucceeded()
+}
+
+// staleKey returns the key of the stale copy of a cache
+// entry, see Client.StaleExpiry.
+func staleKey(key string) string {
+	return key + "-stale"
+}
+
+// cacheContext returns the context limited by the
+// CacheTimeout, if set.
+func (c *Client) cacheContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.CacheTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.CacheTimeout)
+}
+
+// cacheSucceeded reports a working cache to the breaker.
+func (c *Client) cacheSucceeded() {
+	if c.CacheBreaker != nil {
+		c.CacheBreaker.Success()
+	}
+}
+
+// cacheFailed adds the failure to the meta and reports it to
+// the logger and OnCacheError. The breaker is not told of
+// operations it skipped or that failed because the context
+// is done.
+func (c *Client) cacheFailed(ctx context.Context, o Options, meta *Meta, op, key string, err error) {
+	cerr := &CacheError{Op: op, Key: key, Err: err}
+	meta.CacheErrors = append(meta.CacheErrors, cerr)
+
+	level := c.LogLevels.Failure
+	if err == ErrCacheOpen {
+		level = c.LogLevels.Cache
+	} else if c.CacheBreaker != nil && ctx.Err() == nil {
+		c.CacheBreaker.Failure()
+	}
+	c.log(ctx, level, "luminati cache "+op+" failed",
+		slog.String("key", key), slog.String("error", err.Error()))
+	if c.OnCacheError != nil {
+		c.OnCacheError(o, cerr)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/lacuna-seo/luminati/schema"
+	"strings"
+)
+
+const (
+	// FeatureTopStories is the BrightData block name for the
+	// Top Stories carousel.
+	FeatureTopStories = "top_stories"
+	// FeatureVideos is the BrightData block name for the video
+	// carousel.
+	FeatureVideos = "videos"
+	// FeatureTwitter is the BrightData block name for Twitter
+	// and other social cards.
+	FeatureTwitter = "twitter"
+)
+
+type (
+	// TopStory is a single article in the Top Stories
+	// carousel as defined in Serps.
+	TopStory struct {
+		Title      string `json:"title"`
+		Source     string `json:"source,omitempty"`
+		Age        string `json:"age,omitempty"`
+		Link       string `json:"url"`
+		Rank       int    `json:"position"`
+		GlobalRank int    `json:"global_position,omitempty"`
+	}
+	// Video is a single video in the video carousel as
+	// defined in Serps.
+	Video struct {
+		Title       string `json:"title"`
+		Platform    string `json:"platform,omitempty"`
+		Duration    string `json:"duration,omitempty"`
+		DurationSec int    `json:"duration_sec,omitempty"`
+		Link        string `json:"url"`
+		Rank        int    `json:"position"`
+		GlobalRank  int    `json:"global_position,omitempty"`
+	}
+	// SocialCard is a social media card, such as a Twitter
+	// carousel, as defined in Serps. Platform is the social
+	// network, e.g. "twitter" or "facebook".
+	SocialCard struct {
+		Platform   string `json:"platform"`
+		Account    string `json:"account,omitempty"`
+		Title      string `json:"title,omitempty"`
+		Link       string `json:"url"`
+		Rank       int    `json:"position"`
+		GlobalRank int    `json:"global_position,omitempty"`
+	}
+)
+
+// topStories transforms the Top Stories block of the
+// response.
+func topStories(items []schema.CarouselItem) []TopStory {
+	var stories []TopStory
+	for _, i := range items {
+		stories = append(stories, TopStory{
+			Title:      i.Title,
+			Source:     i.Source,
+			Age:        firstNonEmpty(i.Age, i.Date),
+			Link:       i.Link,
+			Rank:       i.Rank,
+			GlobalRank: i.GlobalRank,
+		})
+	}
+	return stories
+}
+
+// videos transforms the video carousel block of the
+// response. The platform falls back to the registrable
+// domain of the link, e.g. youtube.com.
+func videos(items []schema.CarouselItem) []Video {
+	var result []Video
+	for _, i := range items {
+		result = append(result, Video{
+			Title:       i.Title,
+			Platform:    firstNonEmpty(i.Platform, i.Source, RegistrableDomain(i.Link)),
+			Duration:    i.Duration,
+			DurationSec: i.DurationSec,
+			Link:        i.Link,
+			Rank:        i.Rank,
+			GlobalRank:  i.GlobalRank,
+		})
+	}
+	return result
+}
+
+// socialPlatforms are the platforms of social cards by the
+// registrable domain of their link.
+var socialPlatforms = map[string]string{
+	"twitter.com":   "twitter",
+	"x.com":         "twitter",
+	"facebook.com":  "facebook",
+	"instagram.com": "instagram",
+	"linkedin.com":  "linkedin",
+	"tiktok.com":    "tiktok",
+	"youtube.com":   "youtube",
+	"pinterest.com": "pinterest",
+	"reddit.com":    "reddit",
+}
+
+// socialCards transforms the Twitter block of the response,
+// which also holds the cards of other social networks.
+func socialCards(items []schema.CarouselItem) []SocialCard {
+	var cards []SocialCard
+	for _, i := range items {
+		cards = append(cards, SocialCard{
+			Platform:   socialPlatform(i),
+			Account:    firstNonEmpty(i.Account, i.Author, i.Source),
+			Title:      i.Title,
+			Link:       i.Link,
+			Rank:       i.Rank,
+			GlobalRank: i.GlobalRank,
+		})
+	}
+	return cards
+}
+
+// socialPlatform returns the platform of a social card from
+// the host of its link, falling back to the platform given
+// by BrightData, the registrable domain of the link and
+// lastly the block the card came from.
+func socialPlatform(i schema.CarouselItem) string {
+	domain := RegistrableDomain(i.Link)
+	if p, ok := socialPlatforms[domain]; ok {
+		return p
+	}
+	return firstNonEmpty(strings.ToLower(i.Platform), domain, FeatureTwitter)
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/lacuna-seo/luminati/schema"
+)
+
+const carouselTestData = `{
+	"top_stories": [
+		{"title": "Apple unveils new MacBook", "source": "The Verge", "age": "2 hours ago", "link": "https://www.theverge.com/apple-macbook", "rank": 1, "global_rank": 3},
+		{"title": "MacBook Air review", "source": "Apple Newsroom", "date": "1 day ago", "link": "https://www.apple.com/newsroom/macbook-air", "rank": 2, "global_rank": 3}
+	],
+	"videos": [
+		{"title": "MacBook Pro Review", "duration": "12:01", "duration_sec": 721, "link": "https://www.youtube.com/watch?v=1", "rank": 1, "global_rank": 5},
+		{"title": "Introducing MacBook Air", "source": "Apple", "link": "https://www.apple.com/macbook-air/video", "rank": 2, "global_rank": 5}
+	],
+	"twitter": {"account": "@Apple", "title": "Apple (@Apple) · Twitter", "link": "https://twitter.com/Apple", "rank": 1, "global_rank": 7}
+}`
+
+func (t *LuminatiTestSuite) TestCarousels() {
+	r, err := schema.Parse([]byte(carouselTestData))
+	t.NoError(err)
+
+	t.Equal([]TopStory{
+		{Title: "Apple unveils new MacBook", Source: "The Verge", Age: "2 hours ago", Link: "https://www.theverge.com/apple-macbook", Rank: 1, GlobalRank: 3},
+		{Title: "MacBook Air review", Source: "Apple Newsroom", Age: "1 day ago", Link: "https://www.apple.com/newsroom/macbook-air", Rank: 2, GlobalRank: 3},
+	}, topStories(r.TopStories))
+
+	t.Equal([]Video{
+		{Title: "MacBook Pro Review", Platform: "youtube.com", Duration: "12:01", DurationSec: 721, Link: "https://www.youtube.com/watch?v=1", Rank: 1, GlobalRank: 5},
+		{Title: "Introducing MacBook Air", Platform: "Apple", Link: "https://www.apple.com/macbook-air/video", Rank: 2, GlobalRank: 5},
+	}, videos(r.Videos))
+
+	t.Equal([]SocialCard{
+		{Platform: FeatureTwitter, Account: "@Apple", Title: "Apple (@Apple) · Twitter", Link: "https://twitter.com/Apple", Rank: 1, GlobalRank: 7},
+	}, socialCards(r.Twitter))
+}
+
+func (t *LuminatiTestSuite) TestSocialCards_Platform() {
+	items := []schema.CarouselItem{
+		{Link: "https://x.com/Apple"},
+		{Link: "https://www.facebook.com/apple", Platform: "Twitter"},
+		{Link: "https://m.instagram.com/apple"},
+		{Link: "https://mastodon.social/@apple", Platform: "Mastodon"},
+		{Link: "https://bsky.app/profile/apple"},
+		{},
+	}
+
+	var got []string
+	for _, c := range socialCards(items) {
+		got = append(got, c.Platform)
+	}
+	t.Equal([]string{"twitter", "facebook", "instagram", "mastodon", "bsky.app", FeatureTwitter}, got)
+}
+
+func (t *LuminatiTestSuite) TestCarousels_BadBlock() {
+	r, err := schema.Parse([]byte(`{"top_stories": "wrong", "videos": 1, "twitter": [1]}`))
+	t.NoError(err)
+	t.Equal([]string{FeatureTopStories, FeatureTwitter, FeatureVideos}, r.Invalid)
+	t.Nil(topStories(r.TopStories))
+	t.Nil(videos(r.Videos))
+	t.Nil(socialCards(r.Twitter))
+}
+
+func (t *LuminatiTestSuite) TestSerps_CheckURL_Carousels() {
+	got, err := toSerps([]byte(carouselTestData))
+	t.NoError(err)
+	t.ElementsMatch([]string{FeatureTopStories, FeatureVideos, FeatureTwitter}, got.Features)
+
+	d := got.CheckURL(TestURL)
+	t.Equal([]TopStory{got.TopStories[1]}, d.TopStories)
+	t.Equal([]Video{got.Videos[1]}, d.Videos)
+	t.Nil(d.Social)
+	t.Equal("top_stories,videos", d.Query.Features)
+
+	d = got.CheckURL("https://twitter.com/Apple")
+	t.Equal(got.Social, d.Social)
+	t.Equal("twitter", d.Query.Features)
+
+	got.Social = append(got.Social, SocialCard{Platform: "facebook", Link: "https://www.facebook.com/Apple", Rank: 2})
+	t.Equal("facebook", got.CheckURL("https://www.facebook.com/Apple").Query.Features)
+}
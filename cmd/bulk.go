@@ -0,0 +1,520 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/lacuna-seo/luminati"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultConcurrency is the number of lookups performed
+	// at once by the bulk command.
+	DefaultConcurrency = 5
+)
+
+type (
+	// bulkConfig is the configuration of the bulk command.
+	bulkConfig struct {
+		config
+		Input       string
+		InputFormat string
+		Output      string
+		Checkpoint  string
+		Failures    string
+		Concurrency int
+		Progress    time.Duration
+	}
+	// bulkRow is a single keyword read from the input file.
+	bulkRow struct {
+		Line int `json:"line"`
+		query
+	}
+	// bulkResult is the CheckURL result of a row.
+	bulkResult struct {
+		bulkRow
+		Rank       int    `json:"position"`
+		RankingURL string `json:"ranking_url"`
+		Features   string `json:"features"`
+		Err        error  `json:"-"`
+	}
+	// bulkFailure is a row that failed, written to the
+	// failures file. It can be used as the input of another
+	// bulk run.
+	bulkFailure struct {
+		bulkRow
+		Error string `json:"error"`
+	}
+)
+
+// bulkHeader is the header of CSV results.
+var bulkHeader = []string{"keyword", "country", "device", "url", "position", "ranking_url", "features"}
+
+// bulk looks up every row of a CSV or NDJSON file and writes
+// the CheckURL result of each.
+func (c *cli) bulk(ctx context.Context, args []string) int {
+	cfg := bulkConfig{}
+	fs := c.flags("bulk", &cfg.config, FormatCSV, FormatNDJSON)
+	fs.StringVar(&cfg.InputFormat, "input-format", "", "format of the input, csv or ndjson, defaults to the file extension")
+	fs.StringVar(&cfg.Output, "output", "", "file to append results to, defaults to stdout")
+	fs.StringVar(&cfg.Checkpoint, "checkpoint", "", "file recording completed rows to resume from, defaults to the output, or input, file with a .checkpoint extension")
+	fs.StringVar(&cfg.Failures, "failures", "", "file to append failed rows to as NDJSON, defaults to the output, or input, file with a .failures.ndjson extension")
+	fs.IntVar(&cfg.Concurrency, "concurrency", DefaultConcurrency, "number of lookups performed at once")
+	fs.DurationVar(&cfg.Progress, "progress", 2*time.Second, "interval between progress reports, zero to disable")
+	arguments, code := c.parse(fs, &cfg.config, args, 1, "<file>", FormatCSV, FormatNDJSON)
+	if arguments == nil {
+		return code
+	}
+	cfg.Input = arguments[0]
+
+	if err := cfg.defaults(); err != nil {
+		fmt.Fprintf(c.stderr, "luminati: %s\n", err)
+		return ExitUsage
+	}
+
+	rows, err := c.readRows(cfg)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	done, err := readCheckpoint(cfg.Checkpoint)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	client, err := c.newClient(cfg.config)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	b, err := c.newBulkRun(cfg)
+	if err != nil {
+		return c.fail(err)
+	}
+	defer b.close()
+
+	var pending []bulkRow
+	for _, r := range rows {
+		if done[r.key()] {
+			b.skipped++
+			continue
+		}
+		pending = append(pending, r)
+	}
+	b.total = len(rows)
+	if b.skipped > 0 {
+		fmt.Fprintf(c.stderr, "bulk: resuming from %s, %d rows already done\n", cfg.Checkpoint, b.skipped)
+	}
+
+	b.run(ctx, client, cfg, pending)
+
+	fmt.Fprintf(c.stderr, "bulk: %s\n", b.progress())
+
+	switch {
+	case ctx.Err() != nil:
+		return ExitInterrupted
+	case b.failed > 0:
+		return ExitError
+	}
+	return ExitOK
+}
+
+// defaults checks the bulk flags and assigns the input format,
+// checkpoint and failures when they are not passed. The
+// checkpoint and failures are kept beside the output file,
+// or the input file when writing to stdout, and are not
+// kept at all when reading stdin and writing to stdout.
+func (cfg *bulkConfig) defaults() error {
+	if cfg.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	if cfg.InputFormat == "" {
+		cfg.InputFormat = FormatCSV
+		switch strings.ToLower(filepath.Ext(cfg.Input)) {
+		case ".ndjson", ".jsonl":
+			cfg.InputFormat = FormatNDJSON
+		}
+	}
+	if cfg.InputFormat != FormatCSV && cfg.InputFormat != FormatNDJSON {
+		return fmt.Errorf("invalid input format %q, use %s or %s", cfg.InputFormat, FormatCSV, FormatNDJSON)
+	}
+	base := cfg.Output
+	if base == "" && cfg.Input != "-" {
+		base = cfg.Input
+	}
+	if base != "" {
+		base = strings.TrimSuffix(base, filepath.Ext(base))
+		if cfg.Checkpoint == "" {
+			cfg.Checkpoint = base + ".checkpoint"
+		}
+		if cfg.Failures == "" {
+			cfg.Failures = base + ".failures.ndjson"
+		}
+	}
+	return nil
+}
+
+// readRows reads the rows of the input file, or stdin if the
+// file is "-".
+func (c *cli) readRows(cfg bulkConfig) ([]bulkRow, error) {
+	r := c.stdin
+	if cfg.Input != "-" {
+		f, err := os.Open(cfg.Input)
+		if err != nil {
+			return nil, errors.Wrap(err, "error opening input")
+		}
+		defer f.Close()
+		r = f
+	}
+	if cfg.InputFormat == FormatNDJSON {
+		return readNDJSONRows(r)
+	}
+	return readCSVRows(r)
+}
+
+// readCSVRows reads rows from CSV with a header containing
+// at least a keyword column. The country, device and url
+// columns are optional.
+func readCSVRows(r io.Reader) ([]bulkRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "error reading csv header")
+	}
+
+	columns := make(map[string]int)
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := columns["keyword"]; !ok {
+		return nil, errors.New("csv header has no keyword column")
+	}
+
+	get := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []bulkRow
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "error reading csv")
+		}
+		row := bulkRow{Line: line, query: query{
+			Keyword: get(record, "keyword"),
+			Country: get(record, "country"),
+			Device:  get(record, "device"),
+			URL:     get(record, "url"),
+		}}
+		if row.Keyword == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// readNDJSONRows reads rows from newline delimited JSON
+// objects with keyword, country, device and url keys.
+func readNDJSONRows(r io.Reader) ([]bulkRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []bulkRow
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		row := bulkRow{}
+		if err := json.Unmarshal([]byte(text), &row); err != nil {
+			return nil, errors.Wrapf(err, "error reading ndjson line %d", line)
+		}
+		row.Line = line
+		if row.Keyword == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading ndjson")
+	}
+
+	return rows, nil
+}
+
+// key identifies the row in the checkpoint file.
+func (r bulkRow) key() string {
+	return strings.Join([]string{strings.ToLower(r.Keyword), strings.ToLower(r.Country), strings.ToLower(r.Device), r.URL}, "\t")
+}
+
+// readCheckpoint returns the keys of the rows completed by
+// a previous run, an empty set if there is no checkpoint.
+func readCheckpoint(path string) (map[string]bool, error) {
+	done := make(map[string]bool)
+	if path == "" {
+		return done, nil
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return done, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "error opening checkpoint")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if key := scanner.Text(); key != "" {
+			done[key] = true
+		}
+	}
+	return done, errors.Wrap(scanner.Err(), "error reading checkpoint")
+}
+
+// bulkRun writes the results, checkpoint and failures of a
+// bulk command as rows complete.
+type bulkRun struct {
+	c          *cli
+	format     string
+	results    io.Writer
+	csv        *csv.Writer
+	checkpoint io.Writer
+	failures   io.Writer
+	// failuresPath is opened on the first failure, so no
+	// file is left behind by a run without any.
+	failuresPath string
+	files        []*os.File
+	mtx          sync.Mutex
+	total        int
+	completed    int
+	failed       int
+	skipped      int
+}
+
+// newBulkRun opens the output and checkpoint files for
+// appending.
+func (c *cli) newBulkRun(cfg bulkConfig) (*bulkRun, error) {
+	b := &bulkRun{c: c, format: cfg.Format, results: c.stdout}
+
+	header := true
+	if cfg.Output != "" {
+		f, err := b.open(cfg.Output)
+		if err != nil {
+			b.close()
+			return nil, err
+		}
+		if info, err := f.Stat(); err == nil && info.Size() > 0 {
+			header = false
+		}
+		b.results = f
+	}
+
+	if cfg.Checkpoint != "" {
+		f, err := b.open(cfg.Checkpoint)
+		if err != nil {
+			b.close()
+			return nil, err
+		}
+		b.checkpoint = f
+	}
+
+	b.failuresPath = cfg.Failures
+
+	if b.format == FormatCSV {
+		b.csv = csv.NewWriter(b.results)
+		if header {
+			if err := b.csv.Write(bulkHeader); err != nil {
+				b.close()
+				return nil, err
+			}
+			b.csv.Flush()
+		}
+	}
+
+	return b, nil
+}
+
+// open opens a file for appending.
+func (b *bulkRun) open(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening "+path)
+	}
+	b.files = append(b.files, f)
+	return f, nil
+}
+
+// close closes every file opened by the run.
+func (b *bulkRun) close() {
+	for _, f := range b.files {
+		_ = f.Close()
+	}
+	b.files = nil
+}
+
+// run looks up the rows concurrently until they are all
+// processed or the context is cancelled.
+func (b *bulkRun) run(ctx context.Context, client luminati.KeywordFinder, cfg bulkConfig, rows []bulkRow) {
+	jobs := make(chan bulkRow)
+	results := make(chan bulkResult)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range jobs {
+				results <- lookup(ctx, client, cfg, row)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, row := range rows {
+			select {
+			case jobs <- row:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var tick <-chan time.Time
+	if cfg.Progress > 0 {
+		ticker := time.NewTicker(cfg.Progress)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case r, ok := <-results:
+			if !ok {
+				return
+			}
+			b.record(ctx, r)
+		case <-tick:
+			fmt.Fprintf(b.c.stderr, "bulk: %s\n", b.progress())
+		}
+	}
+}
+
+// lookup performs the CheckURL lookup of a single row.
+func lookup(ctx context.Context, client luminati.KeywordFinder, cfg bulkConfig, row bulkRow) bulkResult {
+	result := bulkResult{bulkRow: row}
+
+	o, err := cfg.resolve(row.query)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	serps, _, err := client.JSON(ctx, o)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	domain := serps.CheckURL(row.URL)
+	result.Rank = domain.Query.Rank
+	result.RankingURL = domain.Query.Link
+	result.Features = domain.Query.Features
+
+	return result
+}
+
+// record writes the result and checkpoint of a completed
+// row, or the failure. Rows interrupted by the context are
+// neither, so they are retried when resuming.
+func (b *bulkRun) record(ctx context.Context, r bulkResult) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if r.Err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		b.failed++
+		if b.failures == nil && b.failuresPath != "" {
+			f, err := b.open(b.failuresPath)
+			if err != nil {
+				fmt.Fprintf(b.c.stderr, "bulk: %s\n", err)
+			} else {
+				b.failures = f
+			}
+			b.failuresPath = ""
+		}
+		if b.failures != nil {
+			buf, _ := json.Marshal(bulkFailure{bulkRow: r.bulkRow, Error: r.Err.Error()})
+			_, _ = b.failures.Write(append(buf, '\n'))
+		}
+		fmt.Fprintf(b.c.stderr, "bulk: line %d %q failed: %s\n", r.Line, r.Keyword, r.Err)
+		return
+	}
+
+	var err error
+	if b.csv != nil {
+		err = b.csv.Write([]string{r.Keyword, r.Country, r.Device, r.URL, strconv.Itoa(r.Rank), r.RankingURL, r.Features})
+		b.csv.Flush()
+		if err == nil {
+			err = b.csv.Error()
+		}
+	} else {
+		buf, _ := json.Marshal(r)
+		_, err = b.results.Write(append(buf, '\n'))
+	}
+	if err != nil {
+		b.failed++
+		fmt.Fprintf(b.c.stderr, "bulk: line %d %q not written: %s\n", r.Line, r.Keyword, err)
+		return
+	}
+
+	b.completed++
+	if b.checkpoint != nil {
+		_, _ = io.WriteString(b.checkpoint, r.key()+"\n")
+	}
+}
+
+// progress returns a summary of the rows processed so far.
+func (b *bulkRun) progress() string {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return fmt.Sprintf("%d/%d done, %d failed, %d skipped", b.completed+b.skipped, b.total, b.failed, b.skipped)
+}
@@ -0,0 +1,191 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// bulkCSV is an input file with a row that fails.
+const bulkCSV = `keyword,country,device,url
+macbook,us,desktop,https://www.apple.com
+macbook air,,,https://www.currys.co.uk
+ipad,gb,tablet,https://www.apple.com
+`
+
+func TestCLI_Bulk(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "keywords.csv")
+	output := filepath.Join(dir, "results.csv")
+	failures := filepath.Join(dir, "failures.ndjson")
+	assert.NoError(t, os.WriteFile(input, []byte(bulkCSV), 0o644))
+
+	args := []string{"bulk", "-output", output, "-failures", failures, "-concurrency", "2", "-progress", "0", input}
+	env := map[string]string{EnvURL: "http://brightdata.com"}
+
+	f := &finder{serps: testSerps}
+	c, _, stderr := setup(f, env)
+	code := c.run(context.Background(), args)
+	assert.Equal(t, ExitError, code)
+	assert.Len(t, f.calls, 2)
+	assert.Contains(t, stderr.String(), "2/3 done, 1 failed, 0 skipped")
+
+	results, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(results), "keyword,country,device,url,position,ranking_url,features\n"))
+	assert.Contains(t, string(results), "macbook,us,desktop,https://www.apple.com,1,https://www.apple.com/macbook-air/,")
+	assert.Contains(t, string(results), "macbook air,,,https://www.currys.co.uk,2,https://www.currys.co.uk/macbook,")
+
+	failed, err := os.ReadFile(failures)
+	assert.NoError(t, err)
+	assert.Contains(t, string(failed), `{"line":4,"keyword":"ipad","country":"gb","device":"tablet","url":"https://www.apple.com","error":"invalid device \"tablet\", use desktop or mobile"}`)
+
+	checkpoint, err := os.ReadFile(filepath.Join(dir, "results.checkpoint"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(checkpoint), "\n"))
+
+	// Resuming skips the completed rows and appends without
+	// writing the header again.
+	f = &finder{serps: testSerps}
+	c, _, stderr = setup(f, env)
+	code = c.run(context.Background(), args)
+	assert.Equal(t, ExitError, code)
+	assert.Len(t, f.calls, 0)
+	assert.Contains(t, stderr.String(), "bulk: resuming from "+filepath.Join(dir, "results.checkpoint")+", 2 rows already done")
+	assert.Contains(t, stderr.String(), "2/3 done, 1 failed, 2 skipped")
+
+	resumed, err := os.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Equal(t, string(results), string(resumed))
+}
+
+func TestCLI_Bulk_NDJSON(t *testing.T) {
+	f := &finder{serps: testSerps}
+	c, stdout, _ := setup(f, map[string]string{EnvURL: "http://brightdata.com"})
+	c.stdin = strings.NewReader("{\"keyword\":\"macbook\",\"url\":\"https://www.apple.com\"}\n\n{\"keyword\":\"ipad\",\"device\":\"Desktop\"}\n")
+
+	code := c.run(context.Background(), []string{"bulk", "-input-format", "ndjson", "-format", "ndjson", "-failures", "", "-concurrency", "1", "-"})
+	assert.Equal(t, ExitOK, code)
+	assert.Len(t, f.calls, 2)
+	assert.False(t, f.calls[0].Desktop)
+	assert.True(t, f.calls[1].Desktop)
+	assert.Contains(t, stdout.String(), `{"line":1,"keyword":"macbook","url":"https://www.apple.com","position":1,"ranking_url":"https://www.apple.com/macbook-air/","features":""}`)
+	assert.Contains(t, stdout.String(), `{"line":3,"keyword":"ipad","device":"Desktop","position":0,"ranking_url":"","features":""}`)
+}
+
+func TestCLI_Bulk_Errors(t *testing.T) {
+	dir := t.TempDir()
+	noKeyword := filepath.Join(dir, "urls.csv")
+	assert.NoError(t, os.WriteFile(noKeyword, []byte("url\nhttps://www.apple.com\n"), 0o644))
+	badJSON := filepath.Join(dir, "keywords.ndjson")
+	assert.NoError(t, os.WriteFile(badJSON, []byte("{\"keyword\":\"macbook\"}\n{wrong\n"), 0o644))
+
+	tt := map[string]struct {
+		args []string
+		code int
+		want string
+	}{
+		"No File": {
+			[]string{"bulk"}, ExitUsage, "Usage: luminati bulk",
+		},
+		"Missing File": {
+			[]string{"bulk", filepath.Join(dir, "missing.csv")}, ExitError, "error opening input",
+		},
+		"No Keyword Column": {
+			[]string{"bulk", noKeyword}, ExitError, "csv header has no keyword column",
+		},
+		"Bad NDJSON": {
+			[]string{"bulk", badJSON}, ExitError, "error reading ndjson line 2",
+		},
+		"Bad Input Format": {
+			[]string{"bulk", "-input-format", "xml", noKeyword}, ExitUsage, `invalid input format "xml"`,
+		},
+		"Bad Concurrency": {
+			[]string{"bulk", "-concurrency", "0", noKeyword}, ExitUsage, "concurrency must be at least 1",
+		},
+		"Table Format": {
+			[]string{"bulk", "-format", "table", noKeyword}, ExitUsage, `invalid format "table"`,
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			c, _, stderr := setup(&finder{}, map[string]string{EnvURL: "http://brightdata.com"})
+			code := c.run(context.Background(), test.args)
+			assert.Equal(t, test.code, code)
+			assert.Contains(t, stderr.String(), test.want)
+		})
+	}
+}
+
+func TestCLI_Bulk_Interrupted(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "keywords.csv")
+	output := filepath.Join(dir, "results.csv")
+	assert.NoError(t, os.WriteFile(input, []byte(bulkCSV), 0o644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f := &finder{err: context.Canceled}
+	c, _, _ := setup(f, map[string]string{EnvURL: "http://brightdata.com"})
+	code := c.run(ctx, []string{"bulk", "-output", output, "-failures", filepath.Join(dir, "failures.ndjson"), input})
+	assert.Equal(t, ExitInterrupted, code)
+
+	checkpoint, err := os.ReadFile(filepath.Join(dir, "results.checkpoint"))
+	assert.NoError(t, err)
+	assert.Empty(t, checkpoint)
+	_, err = os.Stat(filepath.Join(dir, "failures.ndjson"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCLI_Bulk_Stdout(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "keywords.csv")
+	assert.NoError(t, os.WriteFile(input, []byte(bulkCSV), 0o644))
+	args := []string{"bulk", "-progress", "0", input}
+	env := map[string]string{EnvURL: "http://brightdata.com"}
+
+	f := &finder{serps: testSerps}
+	c, stdout, _ := setup(f, env)
+	code := c.run(context.Background(), args)
+	assert.Equal(t, ExitError, code)
+	assert.Len(t, f.calls, 2)
+	assert.Contains(t, stdout.String(), "macbook,us,desktop,https://www.apple.com,1,")
+
+	checkpoint, err := os.ReadFile(filepath.Join(dir, "keywords.checkpoint"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(checkpoint), "\n"))
+	failed, err := os.ReadFile(filepath.Join(dir, "keywords.failures.ndjson"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(failed), `"keyword":"ipad"`)
+
+	// Resuming with stdout output skips the completed rows.
+	f = &finder{serps: testSerps}
+	c, _, stderr := setup(f, env)
+	code = c.run(context.Background(), args)
+	assert.Equal(t, ExitError, code)
+	assert.Len(t, f.calls, 0)
+	assert.Contains(t, stderr.String(), "2 rows already done")
+}
+
+func TestCLI_Bulk_NoFailures(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "keywords.csv")
+	output := filepath.Join(dir, "results.csv")
+	assert.NoError(t, os.WriteFile(input, []byte("keyword\nmacbook\n"), 0o644))
+
+	c, _, _ := setup(&finder{serps: testSerps}, map[string]string{EnvURL: "http://brightdata.com"})
+	code := c.run(context.Background(), []string{"bulk", "-output", output, "-progress", "0", input})
+	assert.Equal(t, ExitOK, code)
+
+	_, err := os.Stat(filepath.Join(dir, "results.failures.ndjson"))
+	assert.True(t, os.IsNotExist(err))
+}
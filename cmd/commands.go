@@ -0,0 +1,165 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// serp prints the organic results for a keyword.
+func (c *cli) serp(ctx context.Context, args []string) int {
+	cfg := config{}
+	fs := c.flags("serp", &cfg, formats...)
+	keywords, code := c.parse(fs, &cfg, args, 1, "<keyword>", formats...)
+	if keywords == nil {
+		return code
+	}
+
+	client, err := c.newClient(cfg)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	serps, _, err := client.JSON(ctx, cfg.options(keywords[0]))
+	if err != nil {
+		return c.fail(err)
+	}
+
+	r := report{
+		Value:  serps,
+		Header: []string{"position", "url", "title", "text"},
+	}
+	for _, o := range serps.Organic {
+		r.Lines = append(r.Lines, o)
+		r.Rows = append(r.Rows, []string{strconv.Itoa(o.Rank), o.Link, o.Title, o.Description})
+	}
+
+	if err := r.write(c.stdout, cfg.Format); err != nil {
+		return c.fail(err)
+	}
+
+	return ExitOK
+}
+
+// html prints the raw HTML of the results page for a
+// keyword.
+func (c *cli) html(ctx context.Context, args []string) int {
+	cfg := config{}
+	fs := c.flags("html", &cfg)
+	keywords, code := c.parse(fs, &cfg, args, 1, "<keyword>")
+	if keywords == nil {
+		return code
+	}
+
+	client, err := c.newClient(cfg)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	html, _, err := client.HTML(ctx, cfg.options(keywords[0]))
+	if err != nil {
+		return c.fail(err)
+	}
+
+	fmt.Fprint(c.stdout, html)
+
+	return ExitOK
+}
+
+// check prints the rank, URL and features of a URL for a
+// keyword. ExitNotRanking is returned if the URL does not
+// rank.
+func (c *cli) check(ctx context.Context, args []string) int {
+	cfg := config{}
+	fs := c.flags("check", &cfg, formats...)
+	arguments, code := c.parse(fs, &cfg, args, 2, "<keyword> <url>", formats...)
+	if arguments == nil {
+		return code
+	}
+	keyword, target := arguments[0], arguments[1]
+
+	client, err := c.newClient(cfg)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	serps, _, err := client.JSON(ctx, cfg.options(keyword))
+	if err != nil {
+		return c.fail(err)
+	}
+
+	domain := serps.CheckURL(target)
+	r := report{
+		Value:  domain,
+		Header: []string{"keyword", "position", "url", "features"},
+		Rows:   [][]string{{keyword, strconv.Itoa(domain.Query.Rank), domain.Query.Link, domain.Query.Features}},
+	}
+	for _, o := range domain.Results {
+		r.Lines = append(r.Lines, o)
+	}
+
+	if err := r.write(c.stdout, cfg.Format); err != nil {
+		return c.fail(err)
+	}
+
+	if domain.Query.Rank == 0 {
+		return ExitNotRanking
+	}
+
+	return ExitOK
+}
+
+// parse parses the flags and validates the configuration,
+// returning the positional arguments. Nil arguments are
+// returned with the exit code if the command should stop.
+// A single required argument joins all that remain, so
+// keywords need not be quoted.
+func (c *cli) parse(fs *flag.FlagSet, cfg *config, args []string, required int, usage string, formats ...string) ([]string, int) {
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: luminati %s [flags] %s\n\nFlags:\n", fs.Name(), usage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil, ExitOK
+		}
+		return nil, ExitUsage
+	}
+
+	if fs.NArg() < required {
+		fs.Usage()
+		return nil, ExitUsage
+	}
+
+	if err := cfg.validate(formats...); err != nil {
+		fmt.Fprintf(c.stderr, "luminati: %s\n", err)
+		return nil, ExitUsage
+	}
+
+	rest := fs.Args()
+	if required == 1 {
+		return []string{strings.Join(rest, " ")}, ExitOK
+	}
+	if len(rest) > required {
+		fs.Usage()
+		return nil, ExitUsage
+	}
+
+	return append([]string{}, rest...), ExitOK
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/ainsleyclark/redigo"
+	"github.com/go-redis/redis/v8"
+	"github.com/lacuna-seo/luminati"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvURL is the environment variable holding the proxy
+	// URL, including the zone credentials.
+	EnvURL = "LUMINATI_URL"
+	// EnvCache is the environment variable holding the Redis
+	// DSN, e.g. redis://localhost:6379/14.
+	EnvCache = "LUMINATI_CACHE"
+	// DefaultTimeout is the time limit of a single lookup
+	// when no timeout flag is passed.
+	DefaultTimeout = time.Minute
+)
+
+var (
+	// errNoURL is returned when neither the url flag nor the
+	// LUMINATI_URL environment variable are set.
+	errNoURL = errors.New("no proxy url, pass -url or export " + EnvURL)
+)
+
+type (
+	// config is the configuration shared by every command
+	// that performs a lookup.
+	config struct {
+		URL         string
+		Cache       string
+		CacheExpiry time.Duration
+		Timeout     time.Duration
+		Country     string
+		Device      string
+		Params      params
+		Format      string
+		// Rate is the number of requests per second made to
+		// the Luminati API with a burst of Burst, zero for no
+		// limit.
+		Rate  float64
+		Burst int
+	}
+	// params is a repeatable key=value flag.
+	params url.Values
+	// query is a single lookup read from a bulk file or an API
+	// request. Empty fields fall back to the configuration.
+	query struct {
+		Keyword string `json:"keyword"`
+		Country string `json:"country,omitempty"`
+		Device  string `json:"device,omitempty"`
+		URL     string `json:"url,omitempty"`
+	}
+)
+
+// flags returns a flag set for the command with the shared
+// configuration flags, defaulting to the environment.
+func (c *cli) flags(name string, cfg *config, formats ...string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+
+	cfg.Params = params{}
+	fs.StringVar(&cfg.URL, "url", c.getenv(EnvURL), "proxy URL including credentials (env "+EnvURL+")")
+	fs.StringVar(&cfg.Cache, "cache", c.getenv(EnvCache), "Redis cache DSN, e.g. redis://localhost:6379/14 (env "+EnvCache+")")
+	fs.DurationVar(&cfg.CacheExpiry, "cache-expiry", luminati.DefaultCacheExpiry, "time results live in the cache")
+	fs.DurationVar(&cfg.Timeout, "timeout", DefaultTimeout, "time limit of the lookup")
+	fs.StringVar(&cfg.Country, "country", luminati.DefaultCountry, "country to obtain results for")
+	fs.StringVar(&cfg.Device, "device", luminati.DeviceMobile, "device to obtain results for, desktop or mobile")
+	fs.Var(&cfg.Params, "param", "additional Google query parameter as key=value, repeatable")
+	if len(formats) > 0 {
+		cfg.Format = formats[0]
+		fs.StringVar(&cfg.Format, "format", formats[0], "output format, one of "+strings.Join(formats, ", "))
+	}
+
+	return fs
+}
+
+// validate checks the configuration after the flags have been
+// parsed.
+func (cfg *config) validate(formats ...string) error {
+	if cfg.URL == "" {
+		return errNoURL
+	}
+	if cfg.Device != luminati.DeviceDesktop && cfg.Device != luminati.DeviceMobile {
+		return fmt.Errorf("invalid device %q, use %s or %s", cfg.Device, luminati.DeviceDesktop, luminati.DeviceMobile)
+	}
+	if len(formats) > 0 && !contains(formats, cfg.Format) {
+		return fmt.Errorf("invalid format %q, use one of %s", cfg.Format, strings.Join(formats, ", "))
+	}
+	return nil
+}
+
+// options returns the lookup options for the keyword.
+func (cfg *config) options(keyword string) luminati.Options {
+	o := luminati.Options{
+		Keyword: keyword,
+		Country: cfg.Country,
+		Desktop: cfg.Device == luminati.DeviceDesktop,
+	}
+	if len(cfg.Params) > 0 {
+		o.Params = url.Values{}
+		for k, v := range cfg.Params {
+			o.Params[k] = append([]string(nil), v...)
+		}
+	}
+	return o
+}
+
+// resolve returns the lookup options for the query, with the
+// configuration used for empty fields.
+func (cfg *config) resolve(q query) (luminati.Options, error) {
+	if strings.TrimSpace(q.Keyword) == "" {
+		return luminati.Options{}, errors.New("no keyword")
+	}
+	qc := *cfg
+	if q.Country != "" {
+		qc.Country = q.Country
+	}
+	if q.Device != "" {
+		qc.Device = strings.ToLower(q.Device)
+	}
+	if qc.Device != luminati.DeviceDesktop && qc.Device != luminati.DeviceMobile {
+		return luminati.Options{}, fmt.Errorf("invalid device %q, use %s or %s", q.Device, luminati.DeviceDesktop, luminati.DeviceMobile)
+	}
+	return qc.options(strings.TrimSpace(q.Keyword)), nil
+}
+
+// newClient creates a luminati client, with a Redis cache if
+// a DSN is configured and a rate limit if a rate is.
+func newClient(cfg config) (luminati.KeywordFinder, error) {
+	var (
+		client *luminati.Client
+		err    error
+	)
+	if cfg.Cache == "" {
+		client, err = luminati.New(cfg.URL)
+	} else {
+		client, err = newCachedClient(cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Rate > 0 {
+		burst := cfg.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		client.Limiter = rate.NewLimiter(rate.Limit(cfg.Rate), burst)
+	}
+
+	return client, nil
+}
+
+// newCachedClient creates a luminati client with the Redis
+// cache at the configured DSN.
+func newCachedClient(cfg config) (*luminati.Client, error) {
+	opts, err := redis.ParseURL(cfg.Cache)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing cache dsn")
+	}
+
+	cache := redigo.New(opts, redigo.NewGoJSONEncoder())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := cache.Ping(ctx); err != nil {
+		return nil, errors.Wrap(err, "error connecting to cache")
+	}
+
+	return luminati.NewWithCache(cfg.URL, cache, cfg.CacheExpiry)
+}
+
+// String implements flag.Value.
+func (p *params) String() string {
+	return url.Values(*p).Encode()
+}
+
+// Set implements flag.Value for key=value pairs.
+func (p *params) Set(s string) error {
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("param %q must be key=value", s)
+	}
+	url.Values(*p).Add(kv[0], kv[1])
+	return nil
+}
+
+// contains returns true if the string is in the slice.
+func contains(s []string, v string) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
@@ -1,22 +1,31 @@
 module github.com/lacuna-seo/luminati/cmd
 
-go 1.16
+go 1.21
 
 require (
-	github.com/briandowns/spinner v1.19.0
-	github.com/enescakir/emoji v1.0.0
-	github.com/gookit/color v1.5.1
-	github.com/manifoldco/promptui v0.9.0
+	github.com/ainsleyclark/redigo v0.0.2
+	github.com/go-redis/redis/v8 v8.11.5
+	github.com/lacuna-seo/luminati v0.0.4
+	github.com/pkg/errors v0.9.1
+	github.com/stretchr/testify v1.8.2
+	golang.org/x/time v0.3.0
 )
 
 require (
-	github.com/ainsleyclark/redigo v0.0.2 // indirect
-	github.com/chzyer/readline v1.5.1 // indirect
-	github.com/fatih/color v1.13.0 // indirect
-	github.com/goccy/go-json v0.9.10 // indirect
-	github.com/k0kubun/pp/v3 v3.1.0 // indirect
-	github.com/lacuna-seo/luminati v0.0.4
-	github.com/mattn/go-colorable v0.1.12 // indirect
-	golang.org/x/net v0.0.0-20220728211354-c7608f3a8462 // indirect
-	golang.org/x/sys v0.0.0-20220731174439-a90be440212d // indirect
+	github.com/cespare/xxhash/v2 v2.1.2 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/goccy/go-json v0.9.7 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/vmihailenco/msgpack/v5 v5.3.5 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	go.opentelemetry.io/otel v1.14.0 // indirect
+	go.opentelemetry.io/otel/trace v1.14.0 // indirect
+	golang.org/x/net v0.23.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/lacuna-seo/luminati => ../
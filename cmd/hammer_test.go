@@ -5,11 +5,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"github.com/enescakir/emoji"
 	"github.com/lacuna-seo/luminati"
-	"log"
+	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -19,44 +20,47 @@ const Amount = 50
 
 // Hammer the Luminati API.
 func Test_Hammer(t *testing.T) {
-	client, err := luminati.New()
+	uri := os.Getenv(EnvURL)
+	if uri == "" {
+		t.Skip("export " + EnvURL + " to hammer the Luminati API")
+	}
+
+	client, err := luminati.New(uri)
 	if err != nil {
-		log.Fatalln(err)
+		t.Fatal(err)
 	}
 
 	opts := luminati.Options{
-		Keyword:  "macbook",
-		Country:  "us",
+		Keyword: "macbook",
+		Country: "us",
 	}
 
 	wg := sync.WaitGroup{}
 
 	var (
-		errors = 0
-		success = 0
+		errors  int64
+		success int64
 	)
 	for i := 0; i < Amount; i++ {
 		wg.Add(1)
-		go run(client, opts, &wg, errors, success)
+		go run(client, opts, &wg, &errors, &success)
 	}
 	wg.Wait()
 
-	fmt.Printf("\n%v Finsihed SERP Hammer Test\n\n", emoji.ChartIncreasing)
-	fmt.Printf("%v Total Errors: %d\n", emoji.CrossMark, errors)
-	fmt.Printf("%v Total Success: %d", emoji.CheckMarkButton, success)
+	fmt.Printf("\nFinished SERP Hammer Test\n\n")
+	fmt.Printf("Total Errors: %d\n", errors)
+	fmt.Printf("Total Success: %d\n", success)
 }
 
 // Runs and prints the response.
-func run(client luminati.KeywordFinder, opts luminati.Options, wg *sync.WaitGroup, errors int, success int) {
+func run(client luminati.KeywordFinder, opts luminati.Options, wg *sync.WaitGroup, errors *int64, success *int64) {
 	defer wg.Done()
-	_, _, err := client.JSON(opts)
+	_, _, err := client.JSON(context.Background(), opts)
 	if err != nil {
-		fmt.Printf("%v Error: %s\n", emoji.CrossMark, err.Error())
-		errors++
+		fmt.Printf("Error: %s\n", err.Error())
+		atomic.AddInt64(errors, 1)
 		return
 	}
-	fmt.Printf("%v Sucess:  %s\n", emoji.CheckMarkButton, opts.Keyword)
-	success++
+	fmt.Printf("Success: %s\n", opts.Keyword)
+	atomic.AddInt64(success, 1)
 }
-
-
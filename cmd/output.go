@@ -0,0 +1,77 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// FormatJSON writes a single indented JSON document.
+	FormatJSON = "json"
+	// FormatNDJSON writes one JSON document per line.
+	FormatNDJSON = "ndjson"
+	// FormatTable writes aligned columns for humans.
+	FormatTable = "table"
+	// FormatCSV writes comma separated values with a header.
+	FormatCSV = "csv"
+)
+
+// formats are the output formats supported by the commands
+// that print results, the first is the default.
+var formats = []string{FormatJSON, FormatNDJSON, FormatTable, FormatCSV}
+
+// report is the output of a command in every format.
+type report struct {
+	// Value is written as a whole for FormatJSON.
+	Value interface{}
+	// Lines are written one per line for FormatNDJSON.
+	Lines []interface{}
+	// Header and Rows are written for FormatTable and
+	// FormatCSV.
+	Header []string
+	Rows   [][]string
+}
+
+// write outputs the report in the format.
+func (r report) write(w io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(r.Value)
+	case FormatNDJSON:
+		enc := json.NewEncoder(w)
+		for _, line := range r.Lines {
+			if err := enc.Encode(line); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(r.Header, "\t")))
+		for _, row := range r.Rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(r.Header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(r.Rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
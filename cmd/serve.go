@@ -0,0 +1,429 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	_ "embed" // For the OpenAPI description.
+	"encoding/json"
+	"fmt"
+	"github.com/lacuna-seo/luminati"
+	"github.com/pkg/errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultAddr is the address the API listens on.
+	DefaultAddr = ":8080"
+	// DefaultRate is the number of requests per second made
+	// to the Luminati API by the server.
+	DefaultRate = 5
+	// DefaultMaxBatch is the maximum number of queries in a
+	// single batch request.
+	DefaultMaxBatch = 100
+	// ShutdownTimeout is the time in flight requests have to
+	// complete once the server is stopped.
+	ShutdownTimeout = 30 * time.Second
+	// DefaultDrain is the time the server keeps serving while
+	// reporting not ready once stopped, so load balancers
+	// stop sending requests before the listener closes.
+	DefaultDrain = 5 * time.Second
+	// readyTimeout limits the dependency checks of /readyz.
+	readyTimeout = 2 * time.Second
+	// maxBody is the maximum size of a request body.
+	maxBody = 1 << 20
+)
+
+// openAPI is the OpenAPI description of the server.
+//
+//go:embed openapi.json
+var openAPI []byte
+
+type (
+	// serveConfig is the configuration of the serve command.
+	serveConfig struct {
+		config
+		Addr        string
+		MaxBatch    int
+		Concurrency int
+		Drain       time.Duration
+	}
+	// server exposes a KeywordFinder over HTTP. A single
+	// client is shared by every request, so the cache and
+	// rate limit of the client apply to all callers.
+	server struct {
+		cfg      serveConfig
+		finder   luminati.KeywordFinder
+		draining int32
+	}
+	// pinger is a KeywordFinder that can check its
+	// dependencies, such as *luminati.Client.
+	pinger interface {
+		Ping(ctx context.Context) error
+	}
+	// batchRequest is the body of POST /batch.
+	batchRequest struct {
+		Queries []query `json:"queries"`
+	}
+	// batchResult is the result of a single query in a batch,
+	// Domain is set when the query has a URL and Serps when
+	// it does not.
+	batchResult struct {
+		query
+		Serps  *luminati.Serps  `json:"serps,omitempty"`
+		Domain *luminati.Domain `json:"domain,omitempty"`
+		Error  string           `json:"error,omitempty"`
+	}
+	// apiError is the body of every error response.
+	apiError struct {
+		Error string `json:"error"`
+	}
+	// statusError is an error with the HTTP status to respond
+	// with.
+	statusError struct {
+		status int
+		err    error
+		// retryAfter, if set, is sent as the Retry-After
+		// header.
+		retryAfter time.Duration
+	}
+)
+
+// serve runs the HTTP API until the context is cancelled.
+func (c *cli) serve(ctx context.Context, args []string) int {
+	cfg := serveConfig{}
+	fs := c.flags("serve", &cfg.config)
+	fs.StringVar(&cfg.Addr, "addr", DefaultAddr, "address to listen on")
+	fs.Float64Var(&cfg.Rate, "rate", DefaultRate, "requests per second made to the Luminati API, zero for no limit")
+	fs.IntVar(&cfg.Burst, "burst", DefaultRate, "requests made to the Luminati API at once before -rate applies")
+	fs.IntVar(&cfg.MaxBatch, "max-batch", DefaultMaxBatch, "maximum number of queries in a batch request")
+	fs.IntVar(&cfg.Concurrency, "concurrency", DefaultConcurrency, "number of lookups performed at once per batch request")
+	fs.DurationVar(&cfg.Drain, "drain", DefaultDrain, "time to keep serving while reporting not ready once stopped")
+	if arguments, code := c.parse(fs, &cfg.config, args, 0, ""); arguments == nil {
+		return code
+	}
+
+	if cfg.MaxBatch < 1 || cfg.Concurrency < 1 {
+		fmt.Fprintln(c.stderr, "luminati: max-batch and concurrency must be at least 1")
+		return ExitUsage
+	}
+	if cfg.Drain < 0 {
+		fmt.Fprintln(c.stderr, "luminati: drain must not be negative")
+		return ExitUsage
+	}
+
+	client, err := c.newClient(cfg.config)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	s := &server{cfg: cfg, finder: client}
+	srv := &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.Serve(lis)
+	}()
+	fmt.Fprintf(c.stderr, "luminati: serving on %s\n", lis.Addr())
+
+	select {
+	case err := <-errs:
+		return c.fail(err)
+	case <-ctx.Done():
+	}
+
+	// Report not ready while still serving, then stop
+	// accepting connections.
+	atomic.StoreInt32(&s.draining, 1)
+	fmt.Fprintf(c.stderr, "luminati: draining for %s\n", cfg.Drain)
+	select {
+	case err := <-errs:
+		return c.fail(err)
+	case <-time.After(cfg.Drain):
+	}
+
+	shutdown, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdown); err != nil {
+		return c.fail(err)
+	}
+
+	return ExitOK
+}
+
+// handler returns the routes of the API.
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/serps", s.get(s.serps))
+	mux.HandleFunc("/html", s.get(s.html))
+	mux.HandleFunc("/check", s.get(s.check))
+	mux.HandleFunc("/batch", s.batch)
+	mux.HandleFunc("/healthz", s.get(s.health))
+	mux.HandleFunc("/readyz", s.get(s.ready))
+	mux.HandleFunc("/openapi.json", s.get(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPI)
+	}))
+	return mux
+}
+
+// get restricts the handler to GET and HEAD requests.
+func (s *server) get(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			respond(w, http.StatusMethodNotAllowed, apiError{Error: "method not allowed"})
+			return
+		}
+		h(w, r)
+	}
+}
+
+// serps responds with the results for the q, country and
+// device parameters.
+func (s *server) serps(w http.ResponseWriter, r *http.Request) {
+	o, err := s.options(r, false)
+	if err != nil {
+		fail(w, err)
+		return
+	}
+
+	serps, err := s.lookup(r.Context(), o)
+	if err != nil {
+		fail(w, err)
+		return
+	}
+
+	respond(w, http.StatusOK, serps)
+}
+
+// html responds with the raw HTML of the results page for
+// the q, country and device parameters.
+func (s *server) html(w http.ResponseWriter, r *http.Request) {
+	o, err := s.options(r, false)
+	if err != nil {
+		fail(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.cfg.Timeout)
+	defer cancel()
+
+	html, _, err := s.finder.HTML(ctx, o)
+	if err != nil {
+		fail(w, upstream(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = fmt.Fprint(w, html)
+}
+
+// check responds with the rank, URL and features of the url
+// parameter for the q, country and device parameters.
+func (s *server) check(w http.ResponseWriter, r *http.Request) {
+	o, err := s.options(r, true)
+	if err != nil {
+		fail(w, err)
+		return
+	}
+
+	serps, err := s.lookup(r.Context(), o)
+	if err != nil {
+		fail(w, err)
+		return
+	}
+
+	respond(w, http.StatusOK, serps.CheckURL(r.URL.Query().Get("url")))
+}
+
+// batch looks up every query of the body concurrently and
+// responds with the results in the same order. Failed
+// queries have an error rather than failing the batch.
+func (s *server) batch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		respond(w, http.StatusMethodNotAllowed, apiError{Error: "method not allowed"})
+		return
+	}
+
+	req := batchRequest{}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		fail(w, &statusError{status: http.StatusBadRequest, err: fmt.Errorf("invalid body: %s", err)})
+		return
+	}
+	switch {
+	case len(req.Queries) == 0:
+		fail(w, &statusError{status: http.StatusBadRequest, err: errors.New("no queries")})
+		return
+	case len(req.Queries) > s.cfg.MaxBatch:
+		fail(w, &statusError{status: http.StatusBadRequest, err: fmt.Errorf("%d queries exceeds the maximum of %d", len(req.Queries), s.cfg.MaxBatch)})
+		return
+	}
+
+	// Check every query before looking any up.
+	opts := make([]luminati.Options, len(req.Queries))
+	for i, q := range req.Queries {
+		o, err := s.cfg.resolve(q)
+		if err != nil {
+			fail(w, &statusError{status: http.StatusBadRequest, err: fmt.Errorf("query %d: %s", i, err)})
+			return
+		}
+		opts[i] = o
+	}
+
+	results := make([]batchResult, len(req.Queries))
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < s.cfg.Concurrency && i < len(opts); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results[j] = s.result(r.Context(), req.Queries[j], opts[j])
+			}
+		}()
+	}
+	for i := range opts {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	respond(w, http.StatusOK, results)
+}
+
+// result looks up a single query of a batch.
+func (s *server) result(ctx context.Context, q query, o luminati.Options) batchResult {
+	result := batchResult{query: q}
+	serps, err := s.lookup(ctx, o)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if q.URL != "" {
+		domain := serps.CheckURL(q.URL)
+		result.Domain = &domain
+		return result
+	}
+	result.Serps = &serps
+	return result
+}
+
+// health responds while the process is running.
+func (s *server) health(w http.ResponseWriter, _ *http.Request) {
+	respond(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// ready responds with 503 once the server is shutting down,
+// so load balancers stop sending requests, or if the client
+// cannot reach its cache.
+func (s *server) ready(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&s.draining) == 1 {
+		respond(w, http.StatusServiceUnavailable, map[string]string{"status": "draining"})
+		return
+	}
+	if p, ok := s.finder.(pinger); ok {
+		ctx, cancel := context.WithTimeout(r.Context(), readyTimeout)
+		defer cancel()
+		if err := p.Ping(ctx); err != nil {
+			respond(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "error": err.Error()})
+			return
+		}
+	}
+	respond(w, http.StatusOK, map[string]string{"status": "ready"})
+}
+
+// options validates the query parameters of the request and
+// returns the lookup options. The url parameter is required
+// if target is true.
+func (s *server) options(r *http.Request, target bool) (luminati.Options, error) {
+	params := r.URL.Query()
+	q := query{
+		Keyword: params.Get("q"),
+		Country: params.Get("country"),
+		Device:  params.Get("device"),
+		URL:     params.Get("url"),
+	}
+	if target && q.URL == "" {
+		return luminati.Options{}, &statusError{status: http.StatusBadRequest, err: errors.New("no url")}
+	}
+	o, err := s.cfg.resolve(q)
+	if err != nil {
+		return luminati.Options{}, &statusError{status: http.StatusBadRequest, err: err}
+	}
+	return o, nil
+}
+
+// lookup obtains the results for the options within the
+// configured timeout.
+func (s *server) lookup(ctx context.Context, o luminati.Options) (luminati.Serps, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	serps, _, err := s.finder.JSON(ctx, o)
+	if err != nil {
+		return luminati.Serps{}, upstream(err)
+	}
+
+	return serps, nil
+}
+
+// upstream returns the status error for a failed lookup. An
+// open breaker is unavailable until it lets a probe through.
+func upstream(err error) error {
+	var berr *luminati.BreakerError
+	switch {
+	case errors.Is(err, luminati.ErrClientTimeout) || errors.Is(err, context.DeadlineExceeded):
+		return &statusError{status: http.StatusGatewayTimeout, err: err}
+	case errors.As(err, &berr):
+		return &statusError{status: http.StatusServiceUnavailable, err: err, retryAfter: time.Until(berr.Until)}
+	case errors.Is(err, luminati.ErrBreakerOpen):
+		return &statusError{status: http.StatusServiceUnavailable, err: err}
+	}
+	return &statusError{status: http.StatusBadGateway, err: err}
+}
+
+// Error implements error.
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+// fail responds with the error, as a 500 unless it is a
+// statusError.
+func fail(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var se *statusError
+	if errors.As(err, &se) {
+		status = se.status
+		if se.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(se.retryAfter.Seconds()))))
+		}
+	}
+	respond(w, status, apiError{Error: err.Error()})
+}
+
+// respond writes the value as JSON with the status.
+func respond(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
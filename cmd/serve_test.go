@@ -0,0 +1,278 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lacuna-seo/luminati"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testServer returns a server using the finder with the
+// default configuration.
+func testServer(f *finder) *server {
+	return &server{
+		cfg: serveConfig{
+			config:      config{Country: luminati.DefaultCountry, Device: luminati.DeviceMobile, Timeout: DefaultTimeout},
+			MaxBatch:    2,
+			Concurrency: 2,
+		},
+		finder: f,
+	}
+}
+
+func TestServer(t *testing.T) {
+	tt := map[string]struct {
+		method string
+		target string
+		body   string
+		finder finder
+		status int
+		want   string
+	}{
+		"Serps": {
+			http.MethodGet, "/serps?q=macbook", "", finder{serps: testSerps}, http.StatusOK, `"url":"https://www.apple.com/macbook-air/"`,
+		},
+		"Serps No Keyword": {
+			http.MethodGet, "/serps", "", finder{}, http.StatusBadRequest, `{"error":"no keyword"}`,
+		},
+		"Serps Bad Device": {
+			http.MethodGet, "/serps?q=macbook&device=tablet", "", finder{}, http.StatusBadRequest, `invalid device \"tablet\"`,
+		},
+		"Serps Method": {
+			http.MethodPost, "/serps?q=macbook", "", finder{}, http.StatusMethodNotAllowed, "method not allowed",
+		},
+		"Serps Error": {
+			http.MethodGet, "/serps?q=macbook", "", finder{err: errors.New("lookup error")}, http.StatusBadGateway, "lookup error",
+		},
+		"Serps Timeout": {
+			http.MethodGet, "/serps?q=macbook", "", finder{err: luminati.ErrClientTimeout}, http.StatusGatewayTimeout, "deadline exceeded",
+		},
+		"Serps Breaker Open": {
+			http.MethodGet, "/serps?q=macbook", "", finder{err: fmt.Errorf("lookup: %w", luminati.ErrBreakerOpen)}, http.StatusServiceUnavailable, "luminati breaker open",
+		},
+		"HTML": {
+			http.MethodGet, "/html?q=macbook", "", finder{html: "<html></html>"}, http.StatusOK, "<html></html>",
+		},
+		"HTML Error": {
+			http.MethodGet, "/html?q=macbook", "", finder{err: errors.New("lookup error")}, http.StatusBadGateway, "lookup error",
+		},
+		"Check": {
+			http.MethodGet, "/check?q=macbook&url=https://www.apple.com", "", finder{serps: testSerps}, http.StatusOK, `"query":{"position":1,"url":"https://www.apple.com/macbook-air/"`,
+		},
+		"Check No URL": {
+			http.MethodGet, "/check?q=macbook", "", finder{}, http.StatusBadRequest, "no url",
+		},
+		"Batch": {
+			http.MethodPost, "/batch", `{"queries":[{"keyword":"macbook"},{"keyword":"macbook","url":"https://www.currys.co.uk"}]}`, finder{serps: testSerps}, http.StatusOK, `{"keyword":"macbook","url":"https://www.currys.co.uk","domain":{"query":{"position":2`,
+		},
+		"Batch Lookup Error": {
+			http.MethodPost, "/batch", `{"queries":[{"keyword":"macbook"}]}`, finder{err: errors.New("lookup error")}, http.StatusOK, `[{"keyword":"macbook","error":"lookup error"}]`,
+		},
+		"Batch Method": {
+			http.MethodGet, "/batch", "", finder{}, http.StatusMethodNotAllowed, "method not allowed",
+		},
+		"Batch Bad Body": {
+			http.MethodPost, "/batch", `{"keywords":["macbook"]}`, finder{}, http.StatusBadRequest, "invalid body",
+		},
+		"Batch Empty": {
+			http.MethodPost, "/batch", `{"queries":[]}`, finder{}, http.StatusBadRequest, "no queries",
+		},
+		"Batch Too Large": {
+			http.MethodPost, "/batch", `{"queries":[{"keyword":"a"},{"keyword":"b"},{"keyword":"c"}]}`, finder{}, http.StatusBadRequest, "3 queries exceeds the maximum of 2",
+		},
+		"Batch Bad Query": {
+			http.MethodPost, "/batch", `{"queries":[{"keyword":"macbook"},{"keyword":""}]}`, finder{}, http.StatusBadRequest, "query 1: no keyword",
+		},
+		"Health": {
+			http.MethodGet, "/healthz", "", finder{}, http.StatusOK, `{"status":"ok"}`,
+		},
+		"Ready": {
+			http.MethodGet, "/readyz", "", finder{}, http.StatusOK, `{"status":"ready"}`,
+		},
+		"OpenAPI": {
+			http.MethodGet, "/openapi.json", "", finder{}, http.StatusOK, `"openapi": "3.0.3"`,
+		},
+		"Not Found": {
+			http.MethodGet, "/wrong", "", finder{}, http.StatusNotFound, "404 page not found",
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			f := test.finder
+			srv := httptest.NewServer(testServer(&f).handler())
+			defer srv.Close()
+
+			req, err := http.NewRequest(test.method, srv.URL+test.target, strings.NewReader(test.body))
+			assert.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Contains(t, string(body), test.want)
+		})
+	}
+}
+
+func TestServer_Options(t *testing.T) {
+	f := &finder{serps: testSerps}
+	srv := httptest.NewServer(testServer(f).handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/serps?q=macbook+air&country=us&device=Desktop")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, f.calls, 1)
+	assert.Equal(t, "macbook air", f.calls[0].Keyword)
+	assert.Equal(t, "us", f.calls[0].Country)
+	assert.True(t, f.calls[0].Desktop)
+}
+
+func TestServer_BreakerOpen(t *testing.T) {
+	f := &finder{err: &luminati.BreakerError{Until: time.Now().Add(30 * time.Second)}}
+	srv := httptest.NewServer(testServer(f).handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/serps?q=macbook")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "30", resp.Header.Get("Retry-After"))
+}
+
+func TestServer_Draining(t *testing.T) {
+	s := testServer(&finder{})
+	s.draining = 1
+	srv := httptest.NewServer(s.handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/readyz")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+// pingFinder is a finder whose dependencies fail with err.
+type pingFinder struct {
+	finder
+	err error
+}
+
+func (f *pingFinder) Ping(_ context.Context) error {
+	return f.err
+}
+
+func TestServer_Ready(t *testing.T) {
+	tt := map[string]struct {
+		err    error
+		status int
+		want   string
+	}{
+		"Ready":       {nil, http.StatusOK, `{"status":"ready"}`},
+		"Unavailable": {errors.New("error pinging luminati cache"), http.StatusServiceUnavailable, `{"error":"error pinging luminati cache","status":"unavailable"}`},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			s := testServer(&finder{})
+			s.finder = &pingFinder{err: test.err}
+			srv := httptest.NewServer(s.handler())
+			defer srv.Close()
+
+			resp, err := http.Get(srv.URL + "/readyz")
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Contains(t, string(body), test.want)
+		})
+	}
+}
+
+func TestCLI_Serve(t *testing.T) {
+	env := map[string]string{EnvURL: "http://brightdata.com"}
+
+	t.Run("Shutdown", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c, _, stderr := setup(&finder{}, env)
+		code := c.run(ctx, []string{"serve", "-addr", "127.0.0.1:0", "-drain", "0"})
+		assert.Equal(t, ExitOK, code)
+		assert.Contains(t, stderr.String(), "luminati: serving on 127.0.0.1:")
+	})
+
+	t.Run("Drain", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		addr := lis.Addr().String()
+		lis.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		c, _, _ := setup(&finder{}, env)
+		done := make(chan int)
+		go func() {
+			done <- c.run(ctx, []string{"serve", "-addr", addr, "-drain", "500ms"})
+		}()
+
+		ready := func() int {
+			resp, err := http.Get("http://" + addr + "/readyz")
+			if err != nil {
+				return 0
+			}
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+		assert.Eventually(t, func() bool { return ready() == http.StatusOK }, time.Second, 10*time.Millisecond)
+
+		// Still serving, but not ready, until the drain ends.
+		cancel()
+		assert.Eventually(t, func() bool { return ready() == http.StatusServiceUnavailable }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, ExitOK, <-done)
+		assert.Equal(t, 0, ready())
+	})
+
+	t.Run("Bad Drain", func(t *testing.T) {
+		c, _, stderr := setup(&finder{}, env)
+		code := c.run(context.Background(), []string{"serve", "-drain", "-1s"})
+		assert.Equal(t, ExitUsage, code)
+		assert.Contains(t, stderr.String(), "drain must not be negative")
+	})
+
+	t.Run("Arguments", func(t *testing.T) {
+		c, _, stderr := setup(&finder{}, env)
+		code := c.run(context.Background(), []string{"serve", "macbook"})
+		assert.Equal(t, ExitUsage, code)
+		assert.Contains(t, stderr.String(), "Usage: luminati serve")
+	})
+
+	t.Run("Help", func(t *testing.T) {
+		c, _, stderr := setup(&finder{}, env)
+		code := c.run(context.Background(), []string{"serve", "-h"})
+		assert.Equal(t, ExitOK, code)
+		assert.Contains(t, stderr.String(), "-max-batch")
+	})
+
+	t.Run("Bad Address", func(t *testing.T) {
+		c, _, stderr := setup(&finder{}, env)
+		code := c.run(context.Background(), []string{"serve", "-addr", "wrong"})
+		assert.Equal(t, ExitError, code)
+		assert.Contains(t, stderr.String(), "listen tcp")
+	})
+}
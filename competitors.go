@@ -0,0 +1,142 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"sort"
+)
+
+type (
+	// CompetitorOptions configures competitor discovery.
+	CompetitorOptions struct {
+		// Target is the client's URL or domain. It is excluded
+		// from the results and used to calculate overlap.
+		Target string
+		// Depth is the number of top organic results
+		// considered per keyword, zero considers all.
+		Depth int
+	}
+	// Competitor is a single registrable domain found across
+	// the keyword set by Competitors.
+	Competitor struct {
+		// Domain is the registrable domain, e.g. bbc.co.uk.
+		Domain string `json:"domain"`
+		// Appearances is the number of organic results the
+		// domain holds across all keywords.
+		Appearances int `json:"appearances"`
+		// Keywords is the number of keywords the domain
+		// ranks for.
+		Keywords int `json:"keywords"`
+		// AverageRank is the mean of the domain's best
+		// position per keyword.
+		AverageRank float64 `json:"average_position"`
+		// BestRank is the domain's best position across all
+		// keywords.
+		BestRank int `json:"best_position"`
+		// Features are the names of the features the domain
+		// owns on at least one keyword.
+		Features []string `json:"features"`
+		// Overlap is the number of keywords where both the
+		// domain and the target rank.
+		Overlap int `json:"overlap"`
+		// OverlapRatio is Overlap divided by the number of
+		// keywords the target ranks for.
+		OverlapRatio float64 `json:"overlap_ratio"`
+	}
+)
+
+// Competitors groups the organic results of the snapshots by
+// registrable domain and returns the domains that appear most
+// often, ordered by the number of keywords they rank for and
+// then by average rank.
+func Competitors(snapshots []Snapshot, opts CompetitorOptions) []Competitor {
+	target := RegistrableDomain(opts.Target)
+
+	type tally struct {
+		Competitor
+		rankSum  int
+		features map[string]bool
+	}
+
+	domains := make(map[string]*tally)
+	targetKeywords := 0
+
+	for _, s := range snapshots {
+		best := make(map[string]int)
+		for _, o := range s.Serps.Organic {
+			if opts.Depth > 0 && o.Rank > opts.Depth {
+				continue
+			}
+			d := RegistrableDomain(o.Link)
+			if d == "" {
+				continue
+			}
+			t, ok := domains[d]
+			if !ok {
+				t = &tally{Competitor: Competitor{Domain: d, BestRank: o.Rank}, features: map[string]bool{}}
+				domains[d] = t
+			}
+			t.Appearances++
+			if r, ok := best[d]; !ok || o.Rank < r {
+				best[d] = o.Rank
+			}
+		}
+
+		_, targetRanks := best[target]
+		if targetRanks {
+			targetKeywords++
+		}
+
+		for d, rank := range best {
+			t := domains[d]
+			t.Keywords++
+			t.rankSum += rank
+			if rank < t.BestRank {
+				t.BestRank = rank
+			}
+			if targetRanks {
+				t.Overlap++
+			}
+			m, err := NewMatcher(d, MatchDomain)
+			if err != nil {
+				continue
+			}
+			for _, f := range s.Serps.ownedFeatures(m) {
+				t.features[f] = true
+			}
+		}
+	}
+
+	result := make([]Competitor, 0, len(domains))
+	for d, t := range domains {
+		if d == target {
+			continue
+		}
+		c := t.Competitor
+		if c.Keywords > 0 {
+			c.AverageRank = float64(t.rankSum) / float64(c.Keywords)
+		}
+		if targetKeywords > 0 {
+			c.OverlapRatio = float64(c.Overlap) / float64(targetKeywords)
+		}
+		for f := range t.features {
+			c.Features = append(c.Features, f)
+		}
+		sort.Strings(c.Features)
+		result = append(result, c)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Keywords != result[j].Keywords {
+			return result[i].Keywords > result[j].Keywords
+		}
+		if result[i].AverageRank != result[j].AverageRank {
+			return result[i].AverageRank < result[j].AverageRank
+		}
+		return result[i].Domain < result[j].Domain
+	})
+
+	return result
+}
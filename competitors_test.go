@@ -0,0 +1,85 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+func (t *LuminatiTestSuite) TestCompetitors() {
+	snapshots := []Snapshot{
+		{Keyword: "macbook", Serps: Serps{Organic: []Organic{
+			{Rank: 1, Link: "https://www.apple.com/mac/"},
+			{Rank: 2, Link: "https://www.bestbuy.com/macbook"},
+			{Rank: 3, Link: "https://support.apple.com/mac"},
+			{Rank: 4, Link: "https://en.wikipedia.org/wiki/MacBook"},
+		}}},
+		{Keyword: "macbook air", Serps: Serps{Organic: []Organic{
+			{Rank: 1, Link: "https://www.bestbuy.com/macbook-air"},
+			{Rank: 2, Link: "https://www.bestbuy.com/macbook-air-m1"},
+			{Rank: 3, Link: "https://www.currys.co.uk/macbook-air"},
+			{Rank: 12, Link: "https://en.wikipedia.org/wiki/MacBook_Air"},
+		}}},
+		{Keyword: "iphone", Serps: Serps{Organic: []Organic{
+			{Rank: 1, Link: "https://www.apple.com/iphone/"},
+			{Rank: 5, Link: "https://www.currys.co.uk/iphone"},
+			{Rank: 6, Link: "postgres://user:abc{"},
+		}}},
+	}
+
+	tt := map[string]struct {
+		opts CompetitorOptions
+		want []Competitor
+	}{
+		"Target": {
+			CompetitorOptions{Target: "https://www.apple.com", Depth: 10},
+			[]Competitor{
+				{Domain: "bestbuy.com", Appearances: 3, Keywords: 2, AverageRank: 1.5, BestRank: 1, Overlap: 1, OverlapRatio: 0.5},
+				{Domain: "currys.co.uk", Appearances: 2, Keywords: 2, AverageRank: 4, BestRank: 3, Overlap: 1, OverlapRatio: 0.5},
+				{Domain: "wikipedia.org", Appearances: 1, Keywords: 1, AverageRank: 4, BestRank: 4, Overlap: 1, OverlapRatio: 0.5},
+			},
+		},
+		"No Target": {
+			CompetitorOptions{},
+			[]Competitor{
+				{Domain: "apple.com", Appearances: 3, Keywords: 2, AverageRank: 1, BestRank: 1},
+				{Domain: "bestbuy.com", Appearances: 3, Keywords: 2, AverageRank: 1.5, BestRank: 1},
+				{Domain: "currys.co.uk", Appearances: 2, Keywords: 2, AverageRank: 4, BestRank: 3},
+				{Domain: "wikipedia.org", Appearances: 2, Keywords: 2, AverageRank: 8, BestRank: 4},
+			},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got := Competitors(snapshots, test.opts)
+			t.Equal(test.want, got)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestCompetitors_Features() {
+	snapshots := []Snapshot{
+		{Keyword: "macbook", Serps: Serps{
+			Organic: []Organic{
+				{Rank: 1, Link: "https://www.apple.com/mac/"},
+				{Rank: 2, Link: "https://www.bestbuy.com/macbook"},
+			},
+			FeaturedSnippets: []FeaturedSnippet{{Link: "https://support.apple.com/mac", Feature: FeatureFeaturedSnippets}},
+			TopStories:       []TopStory{{Link: "https://www.bestbuy.com/news"}},
+			Images:           []Image{{Link: "https://www.apple.com/macbook-air/"}},
+		}},
+		{Keyword: "macbook air", Serps: Serps{
+			Organic: []Organic{
+				{Rank: 1, Link: "https://www.bestbuy.com/macbook-air"},
+			},
+			Videos: []Video{{Link: "https://www.bestbuy.com/video"}},
+			Social: []SocialCard{{Platform: "twitter", Link: "https://twitter.com/apple"}},
+		}},
+	}
+
+	got := Competitors(snapshots, CompetitorOptions{})
+	t.Len(got, 2)
+	t.Equal("bestbuy.com", got[0].Domain)
+	t.Equal([]string{FeatureTopStories, FeatureVideos}, got[0].Features)
+	t.Equal("apple.com", got[1].Domain)
+	t.Equal([]string{FeatureFeaturedSnippets, FeatureImages}, got[1].Features)
+}
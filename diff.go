@@ -0,0 +1,159 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type (
+	// SerpDiff is the change set between two Serps for the
+	// same keyword as returned by Diff.
+	SerpDiff struct {
+		// Entered are the organic results that appear in the
+		// new Serps but not the old.
+		Entered []Organic `json:"entered"`
+		// Left are the organic results that appear in the old
+		// Serps but not the new.
+		Left []Organic `json:"left"`
+		// Moved are the URLs that rank in both with a
+		// different position.
+		Moved []RankChange `json:"moved"`
+		// FeaturesAdded are the features that appeared.
+		FeaturesAdded []string `json:"features_added"`
+		// FeaturesRemoved are the features that disappeared.
+		FeaturesRemoved []string `json:"features_removed"`
+		// Rewrites are the text changes of URLs that rank in
+		// both.
+		Rewrites []Rewrite `json:"rewrites"`
+	}
+	// RankChange is the position change of a single URL.
+	// Delta is positive when the URL moved up.
+	RankChange struct {
+		Link  string `json:"url"`
+		Old   int    `json:"old_position"`
+		New   int    `json:"new_position"`
+		Delta int    `json:"delta"`
+	}
+	// Rewrite is a change of a text field, either the title
+	// or description, for a single URL.
+	Rewrite struct {
+		Link  string `json:"url"`
+		Field string `json:"field"`
+		Old   string `json:"old"`
+		New   string `json:"new"`
+	}
+)
+
+// Diff compares an older (before) and newer (after) Serps
+// for the same keyword. It returns the URLs that entered or
+// left, rank deltas, features that appeared or disappeared
+// and title or description rewrites. URLs are compared by
+// their highest ranking result.
+func Diff(before, after Serps) SerpDiff {
+	d := SerpDiff{}
+
+	oldLinks := organicByLink(before.Organic)
+	newLinks := organicByLink(after.Organic)
+
+	for _, o := range after.Organic {
+		prev, ok := oldLinks[o.Link]
+		if !ok {
+			if newLinks[o.Link].Rank == o.Rank {
+				d.Entered = append(d.Entered, o)
+			}
+			continue
+		}
+		if newLinks[o.Link].Rank != o.Rank {
+			continue
+		}
+		if prev.Rank != o.Rank {
+			d.Moved = append(d.Moved, RankChange{
+				Link:  o.Link,
+				Old:   prev.Rank,
+				New:   o.Rank,
+				Delta: prev.Rank - o.Rank,
+			})
+		}
+		if prev.Title != o.Title {
+			d.Rewrites = append(d.Rewrites, Rewrite{Link: o.Link, Field: "title", Old: prev.Title, New: o.Title})
+		}
+		if prev.Description != o.Description {
+			d.Rewrites = append(d.Rewrites, Rewrite{Link: o.Link, Field: "description", Old: prev.Description, New: o.Description})
+		}
+	}
+
+	for _, o := range before.Organic {
+		if _, ok := newLinks[o.Link]; ok || oldLinks[o.Link].Rank != o.Rank {
+			continue
+		}
+		d.Left = append(d.Left, o)
+	}
+
+	d.FeaturesAdded = stringsDifference(after.Features, before.Features)
+	d.FeaturesRemoved = stringsDifference(before.Features, after.Features)
+
+	return d
+}
+
+// HasChanges determines if anything changed between the two
+// Serps.
+func (d SerpDiff) HasChanges() bool {
+	return len(d.Entered) > 0 || len(d.Left) > 0 || len(d.Moved) > 0 ||
+		len(d.FeaturesAdded) > 0 || len(d.FeaturesRemoved) > 0 || len(d.Rewrites) > 0
+}
+
+// String returns a human-readable rendering of the change
+// set, one change per line.
+func (d SerpDiff) String() string {
+	var b strings.Builder
+	for _, o := range d.Entered {
+		fmt.Fprintf(&b, "+ %s entered at %d\n", o.Link, o.Rank)
+	}
+	for _, o := range d.Left {
+		fmt.Fprintf(&b, "- %s left from %d\n", o.Link, o.Rank)
+	}
+	for _, m := range d.Moved {
+		fmt.Fprintf(&b, "~ %s moved %d -> %d (%+d)\n", m.Link, m.Old, m.New, m.Delta)
+	}
+	for _, f := range d.FeaturesAdded {
+		fmt.Fprintf(&b, "+ feature %s\n", f)
+	}
+	for _, f := range d.FeaturesRemoved {
+		fmt.Fprintf(&b, "- feature %s\n", f)
+	}
+	for _, r := range d.Rewrites {
+		fmt.Fprintf(&b, "* %s %s rewritten: %q -> %q\n", r.Link, r.Field, r.Old, r.New)
+	}
+	return b.String()
+}
+
+// organicByLink returns the highest ranking Organic result
+// for each link.
+func organicByLink(organic []Organic) map[string]Organic {
+	m := make(map[string]Organic, len(organic))
+	for _, o := range organic {
+		if prev, ok := m[o.Link]; ok && prev.Rank <= o.Rank {
+			continue
+		}
+		m[o.Link] = o
+	}
+	return m
+}
+
+// stringsDifference returns the sorted strings in a that are
+// not in b.
+func stringsDifference(a, b []string) []string {
+	var diff []string
+	for _, s := range a {
+		if !stringInSlice(s, b) && !stringInSlice(s, diff) {
+			diff = append(diff, s)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
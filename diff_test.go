@@ -0,0 +1,97 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"encoding/json"
+)
+
+func (t *LuminatiTestSuite) TestDiff() {
+	tt := map[string]struct {
+		before Serps
+		after  Serps
+		want   SerpDiff
+	}{
+		"No Changes": {
+			Serps{Organic: OrganicTestData, Features: []string{"images"}},
+			Serps{Organic: OrganicTestData, Features: []string{"images"}},
+			SerpDiff{},
+		},
+		"Entered & Left": {
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://a.com"}}},
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://b.com"}}},
+			SerpDiff{
+				Entered: []Organic{{Rank: 1, Link: "https://b.com"}},
+				Left:    []Organic{{Rank: 1, Link: "https://a.com"}},
+			},
+		},
+		"Moved": {
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://a.com"}, {Rank: 2, Link: "https://b.com"}}},
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://b.com"}, {Rank: 2, Link: "https://a.com"}}},
+			SerpDiff{
+				Moved: []RankChange{
+					{Link: "https://b.com", Old: 2, New: 1, Delta: 1},
+					{Link: "https://a.com", Old: 1, New: 2, Delta: -1},
+				},
+			},
+		},
+		"Duplicate Links": {
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://a.com"}, {Rank: 3, Link: "https://a.com"}}},
+			Serps{Organic: []Organic{{Rank: 2, Link: "https://a.com"}, {Rank: 5, Link: "https://a.com"}}},
+			SerpDiff{
+				Moved: []RankChange{{Link: "https://a.com", Old: 1, New: 2, Delta: -1}},
+			},
+		},
+		"Features": {
+			Serps{Features: []string{"images", "snack_pack"}},
+			Serps{Features: []string{"people_also_ask", "images"}},
+			SerpDiff{
+				FeaturesAdded:   []string{"people_also_ask"},
+				FeaturesRemoved: []string{"snack_pack"},
+			},
+		},
+		"Rewrites": {
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://a.com", Title: "Old", Description: "old"}}},
+			Serps{Organic: []Organic{{Rank: 1, Link: "https://a.com", Title: "New", Description: "new"}}},
+			SerpDiff{
+				Rewrites: []Rewrite{
+					{Link: "https://a.com", Field: "title", Old: "Old", New: "New"},
+					{Link: "https://a.com", Field: "description", Old: "old", New: "new"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got := Diff(test.before, test.after)
+			t.Equal(test.want, got)
+			t.Equal(name != "No Changes", got.HasChanges())
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestSerpDiff_String() {
+	d := SerpDiff{
+		Entered:         []Organic{{Rank: 1, Link: "https://b.com"}},
+		Left:            []Organic{{Rank: 4, Link: "https://a.com"}},
+		Moved:           []RankChange{{Link: "https://c.com", Old: 5, New: 2, Delta: 3}},
+		FeaturesAdded:   []string{"images"},
+		FeaturesRemoved: []string{"snack_pack"},
+		Rewrites:        []Rewrite{{Link: "https://c.com", Field: "description", Old: "old", New: "new"}},
+	}
+	want := `+ https://b.com entered at 1
+- https://a.com left from 4
+~ https://c.com moved 5 -> 2 (+3)
++ feature images
+- feature snack_pack
+* https://c.com description rewritten: "old" -> "new"
+`
+	t.Equal(want, d.String())
+
+	buf, err := json.Marshal(d)
+	t.NoError(err)
+	t.Contains(string(buf), `"moved":[{"url":"https://c.com","old_position":5,"new_position":2,"delta":3}]`)
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/lacuna-seo/luminati/schema"
+)
+
+// FeatureImages is the BrightData block name for the image
+// pack.
+const FeatureImages = "images"
+
+type (
+	// Image is a single result of the image pack as defined
+	// in Serps.
+	Image struct {
+		Image    string `json:"image"`
+		ImageAlt string `json:"image_alt,omitempty"`
+		ImageURL string `json:"image_url,omitempty"`
+		Tag      string `json:"tag,omitempty"`
+		// Link is the page the image is sourced from, if
+		// BrightData reports one.
+		Link       string `json:"url,omitempty"`
+		Source     string `json:"source,omitempty"`
+		Rank       int    `json:"position"`
+		GlobalRank int    `json:"global_position,omitempty"`
+	}
+	// ImagePack is the placement of the image pack relative to
+	// the organic results, as returned by
+	// Serps.ImagePackPosition.
+	ImagePack struct {
+		// GlobalRank is the global rank of the first image.
+		GlobalRank int `json:"global_position"`
+		// AfterOrganic is the number of organic results placed
+		// above the image pack, zero if it sits above all of
+		// them.
+		AfterOrganic int `json:"after_organic"`
+	}
+)
+
+// SourceDomain returns the registrable domain of the page
+// the image is sourced from, falling back to the image URL
+// when no page is reported.
+func (i Image) SourceDomain() string {
+	return RegistrableDomain(firstNonEmpty(i.Link, i.ImageURL, i.Image))
+}
+
+// ImagesFor returns the image pack results whose source page
+// or image URL satisfies the Matcher.
+func (s *Serps) ImagesFor(m Matcher) []Image {
+	var images []Image
+	for _, i := range s.Images {
+		if (i.Link != "" && m.Match(i.Link)) || (i.ImageURL != "" && m.Match(i.ImageURL)) {
+			images = append(images, i)
+		}
+	}
+	return images
+}
+
+// ImagePackPosition returns the placement of the image pack
+// relative to the organic results. False is returned if
+// there is no image pack or it has no global rank.
+func (s *Serps) ImagePackPosition() (ImagePack, bool) {
+	pack := ImagePack{}
+	for _, i := range s.Images {
+		if i.GlobalRank > 0 && (pack.GlobalRank == 0 || i.GlobalRank < pack.GlobalRank) {
+			pack.GlobalRank = i.GlobalRank
+		}
+	}
+	if pack.GlobalRank == 0 {
+		return ImagePack{}, false
+	}
+	for _, o := range s.Organic {
+		if o.GlobalRank > 0 && o.GlobalRank < pack.GlobalRank {
+			pack.AfterOrganic++
+		}
+	}
+	return pack, true
+}
+
+// images transforms the image pack block of the response.
+func images(items []schema.Image) []Image {
+	var result []Image
+	for _, i := range items {
+		result = append(result, Image{
+			Image:      i.Image,
+			ImageAlt:   i.ImageAlt,
+			ImageURL:   i.ImageURL,
+			Tag:        i.Tag,
+			Link:       firstNonEmpty(i.Link, i.SourceLink),
+			Source:     i.Source,
+			Rank:       i.Rank,
+			GlobalRank: i.GlobalRank,
+		})
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func (t *LuminatiTestSuite) TestImages() {
+	buf, err := os.ReadFile(filepath.Join("testdata", "response.json"))
+	t.NoError(err)
+
+	got, err := toSerps(buf)
+	t.NoError(err)
+
+	t.Len(got.Images, 10)
+	t.Equal("Takeout", got.Images[0].Tag)
+	t.Equal(1, got.Images[0].Rank)
+	t.Equal(18, got.Images[0].GlobalRank)
+	t.Equal("googleusercontent.com", got.Images[0].SourceDomain())
+
+	pack, ok := got.ImagePackPosition()
+	t.True(ok)
+	t.Equal(ImagePack{GlobalRank: 18, AfterOrganic: 10}, pack)
+}
+
+func (t *LuminatiTestSuite) TestSerps_ImagePackPosition() {
+	tt := map[string]struct {
+		serps Serps
+		want  interface{}
+	}{
+		"No Images": {
+			Serps{Organic: OrganicTestData},
+			false,
+		},
+		"No Global Rank": {
+			Serps{Images: []Image{{Rank: 1}}},
+			false,
+		},
+		"Between Organic": {
+			Serps{
+				Organic: []Organic{{Rank: 1, GlobalRank: 2}, {Rank: 2, GlobalRank: 3}, {Rank: 3, GlobalRank: 6}},
+				Images:  []Image{{Rank: 2, GlobalRank: 5}, {Rank: 1, GlobalRank: 4}},
+			},
+			ImagePack{GlobalRank: 4, AfterOrganic: 2},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got, ok := test.serps.ImagePackPosition()
+			if !ok {
+				t.Equal(test.want, ok)
+				return
+			}
+			t.Equal(test.want, got)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestSerps_ImagesFor() {
+	s := Serps{Images: []Image{
+		{Rank: 1, Image: "data:image/png;base64,", Link: "https://www.apple.com/macbook-air/"},
+		{Rank: 2, ImageURL: "https://www.apple.com/images/mac.png"},
+		{Rank: 3, ImageURL: "https://www.bestbuy.com/images/mac.png", Link: "https://www.bestbuy.com/mac"},
+	}}
+
+	t.Equal("apple.com", s.Images[0].SourceDomain())
+
+	d := s.CheckURL(TestURL)
+	t.Equal(s.Images[:2], d.Images)
+	t.Equal(FeatureImages, d.Query.Features)
+
+	d = s.CheckURL("https://www.currys.co.uk")
+	t.Nil(d.Images)
+}
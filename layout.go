@@ -0,0 +1,132 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"encoding/json"
+	"github.com/lacuna-seo/luminati/schema"
+	"sort"
+)
+
+// FeatureOrganic is the BrightData block name for organic
+// results.
+const FeatureOrganic = "organic"
+
+type (
+	// LayoutBlock is a single element of the page as defined
+	// in Serps.Layout. Consecutive items of the same feature,
+	// such as the images of an image pack, are merged into
+	// one block, while every organic result is its own block.
+	LayoutBlock struct {
+		// Feature is the BrightData block name, e.g. organic,
+		// top_ads or people_also_ask.
+		Feature string `json:"feature"`
+		// Position is the 1-based absolute position of the
+		// block on the page.
+		Position int `json:"position"`
+		// GlobalRank is the global rank of the first item in
+		// the block.
+		GlobalRank int `json:"global_position"`
+		// Items is the number of items in the block.
+		Items int `json:"items"`
+		// Rank is the organic rank for organic blocks.
+		Rank int `json:"organic_position,omitempty"`
+		// Link is the URL of organic blocks.
+		Link string `json:"url,omitempty"`
+	}
+	// layoutItem is a single item of any block decoded for its
+	// global rank.
+	layoutItem struct {
+		feature    string
+		index      int
+		globalRank int
+	}
+)
+
+// layoutExcluded are the response blocks that are not part
+// of the page layout.
+var layoutExcluded = []string{"general", "input", "pagination", FeatureOrganic}
+
+// buildLayout orders every element of the raw response with a
+// global rank into blocks and sets the AbsoluteRank of each
+// organic result. Blocks without a global rank are omitted.
+func (s *Serps) buildLayout(m map[string]json.RawMessage) {
+	var items []layoutItem
+	for i, o := range s.Organic {
+		if o.GlobalRank <= 0 {
+			continue
+		}
+		items = append(items, layoutItem{feature: FeatureOrganic, index: i, globalRank: o.GlobalRank})
+	}
+
+	for key, raw := range m {
+		if stringInSlice(key, layoutExcluded) {
+			continue
+		}
+		var blocks []struct {
+			GlobalRank int `json:"global_rank"`
+		}
+		if err := schema.DecodeBlock(raw, &blocks); err != nil {
+			continue
+		}
+		for _, b := range blocks {
+			if b.GlobalRank > 0 {
+				items = append(items, layoutItem{feature: key, globalRank: b.GlobalRank})
+			}
+		}
+	}
+
+	if len(items) == 0 {
+		return
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].globalRank != items[j].globalRank {
+			return items[i].globalRank < items[j].globalRank
+		}
+		return items[i].feature < items[j].feature
+	})
+
+	s.Layout = nil
+	for _, item := range items {
+		n := len(s.Layout)
+		if item.feature != FeatureOrganic && n > 0 && s.Layout[n-1].Feature == item.feature {
+			s.Layout[n-1].Items++
+			continue
+		}
+		block := LayoutBlock{
+			Feature:    item.feature,
+			Position:   n + 1,
+			GlobalRank: item.globalRank,
+			Items:      1,
+		}
+		if item.feature == FeatureOrganic {
+			o := &s.Organic[item.index]
+			o.AbsoluteRank = block.Position
+			block.Rank = o.Rank
+			block.Link = o.Link
+		}
+		s.Layout = append(s.Layout, block)
+	}
+}
+
+// BlocksAbove returns the non-organic blocks placed above
+// the organic result. Nil is returned if the result has no
+// absolute position.
+func (s *Serps) BlocksAbove(o Organic) []LayoutBlock {
+	if o.AbsoluteRank <= 0 {
+		return nil
+	}
+	var blocks []LayoutBlock
+	for _, b := range s.Layout {
+		if b.Position >= o.AbsoluteRank {
+			break
+		}
+		if b.Feature != FeatureOrganic {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
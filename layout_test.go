@@ -0,0 +1,91 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+func (t *LuminatiTestSuite) TestLayout() {
+	buf, err := os.ReadFile(filepath.Join("testdata", "response.json"))
+	t.NoError(err)
+
+	got, err := toSerps(buf)
+	t.NoError(err)
+
+	t.Len(got.Layout, 14)
+	t.Equal(LayoutBlock{Feature: "snack_pack", Position: 1, GlobalRank: 1, Items: 3}, got.Layout[0])
+	t.Equal(LayoutBlock{Feature: "people_also_ask", Position: 2, GlobalRank: 4, Items: 4}, got.Layout[1])
+	t.Equal(LayoutBlock{Feature: FeatureImages, Position: 13, GlobalRank: 18, Items: 10}, got.Layout[12])
+	t.Equal(LayoutBlock{Feature: FeatureRelated, Position: 14, GlobalRank: 28, Items: 8}, got.Layout[13])
+
+	t.Equal(1, got.Organic[0].Rank)
+	t.Equal(3, got.Organic[0].AbsoluteRank)
+	t.Equal(12, got.Organic[9].AbsoluteRank)
+	t.Equal(got.Organic[0].Link, got.Layout[2].Link)
+}
+
+func (t *LuminatiTestSuite) TestSerps_BuildLayout() {
+	tt := map[string]struct {
+		raw  string
+		want []LayoutBlock
+	}{
+		"No Global Rank": {
+			`{"top_ads": [{"rank": 1}]}`,
+			nil,
+		},
+		"Ads And Questions": {
+			`{"top_ads": [{"global_rank": 1}, {"global_rank": 2}], "people_also_ask": {"global_rank": 4}, "bottom_ads": "wrong"}`,
+			[]LayoutBlock{
+				{Feature: "top_ads", Position: 1, GlobalRank: 1, Items: 2},
+				{Feature: FeatureOrganic, Position: 2, GlobalRank: 3, Items: 1, Rank: 1, Link: "https://www.apple.com"},
+				{Feature: "people_also_ask", Position: 3, GlobalRank: 4, Items: 1},
+				{Feature: FeatureOrganic, Position: 4, GlobalRank: 5, Items: 1, Rank: 2, Link: "https://www.bestbuy.com"},
+			},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			m := map[string]json.RawMessage{}
+			t.NoError(json.Unmarshal([]byte(test.raw), &m))
+			s := Serps{Organic: []Organic{
+				{Rank: 1, GlobalRank: 3, Link: "https://www.apple.com"},
+				{Rank: 2, GlobalRank: 5, Link: "https://www.bestbuy.com"},
+			}}
+			if test.want == nil {
+				s.Organic = nil
+			}
+			s.buildLayout(m)
+			t.Equal(test.want, s.Layout)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestSerps_BlocksAbove() {
+	s := Serps{Organic: []Organic{
+		{Rank: 1, GlobalRank: 3, Link: "https://www.apple.com"},
+		{Rank: 2, GlobalRank: 5, Link: "https://www.bestbuy.com"},
+	}}
+	s.buildLayout(map[string]json.RawMessage{
+		"top_ads":         json.RawMessage(`[{"global_rank": 1}, {"global_rank": 2}]`),
+		"people_also_ask": json.RawMessage(`{"global_rank": 4}`),
+	})
+
+	t.Equal([]string{"top_ads"}, layoutFeatures(s.BlocksAbove(s.Organic[0])))
+	t.Equal([]string{"top_ads", "people_also_ask"}, layoutFeatures(s.BlocksAbove(s.Organic[1])))
+	t.Nil(s.BlocksAbove(Organic{Rank: 3}))
+}
+
+// layoutFeatures returns the feature of each block.
+func layoutFeatures(blocks []LayoutBlock) []string {
+	var features []string
+	for _, b := range blocks {
+		features = append(features, b.Feature)
+	}
+	return features
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type (
+	// LogLevels are the levels events are logged at by a
+	// Client, see Client.Logger.
+	LogLevels struct {
+		// Request is the level of lookups starting and
+		// finishing successfully.
+		Request slog.Level
+		// Cache is the level of cache hits and misses.
+		Cache slog.Level
+		// Retry is the level of retried requests.
+		Retry slog.Level
+		// Parse is the level of warnings about the response,
+		// such as schema drift.
+		Parse slog.Level
+		// Failure is the level of failed lookups, failed cache
+		// writes and error responses from the Luminati API.
+		Failure slog.Level
+	}
+)
+
+// DefaultLogLevels are the LogLevels set by New.
+var DefaultLogLevels = LogLevels{
+	Request: slog.LevelDebug,
+	Cache:   slog.LevelDebug,
+	Retry:   slog.LevelWarn,
+	Parse:   slog.LevelWarn,
+	Failure: slog.LevelError,
+}
+
+// redacted replaces the proxy credentials in logged values.
+const redacted = "xxxxx"
+
+// log emits the event if a Logger is set and the level is
+// enabled, redacting the proxy credentials from every string.
+func (c *Client) log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if c.Logger == nil || !c.Logger.Enabled(ctx, level) {
+		return
+	}
+	for i, a := range attrs {
+		if a.Value.Kind() == slog.KindString {
+			attrs[i].Value = slog.StringValue(c.redact(a.Value.String()))
+		}
+	}
+	c.Logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// redact removes the proxy credentials from the string, such
+// as an error from the transport containing the proxy URL.
+// The user and password are replaced together as well as on
+// their own, both raw and escaped as they appear in a URL.
+func (c *Client) redact(s string) string {
+	if c.proxy == nil || c.proxy.User == nil {
+		return s
+	}
+	user := c.proxy.User.Username()
+	password, _ := c.proxy.User.Password()
+	secrets := []string{c.proxy.User.String(), user + ":" + password}
+	for _, v := range []string{password, user} {
+		secrets = append(secrets, url.PathEscape(v), url.QueryEscape(v), v)
+	}
+	for _, v := range secrets {
+		if v != "" && v != ":" {
+			s = strings.ReplaceAll(s, v, redacted)
+		}
+	}
+	return s
+}
+
+// logAttrs returns the logged attributes of the options.
+func (o *Options) logAttrs(format string) []slog.Attr {
+	return []slog.Attr{
+		slog.String("format", format),
+		slog.String("keyword", o.Keyword),
+		slog.String("country", o.Country),
+		slog.String("device", o.device()),
+	}
+}
+
+// logFinished logs the end of a lookup, at the Failure level
+// if it returned an error.
+func (c *Client) logFinished(ctx context.Context, o Options, m RequestMetric, err error) {
+	attrs := append(o.logAttrs(m.Format),
+		slog.String("source", m.Source),
+		slog.Duration("duration", m.Duration),
+		slog.Int("bytes", m.Bytes),
+	)
+	if err != nil {
+		attrs = append(attrs, slog.String("error_class", m.Error), slog.String("error", err.Error()))
+		c.log(ctx, c.LogLevels.Failure, "luminati lookup failed", attrs...)
+		return
+	}
+	c.log(ctx, c.LogLevels.Request, "luminati lookup finished", attrs...)
+}
+
+// logRetry logs a request about to be retried after the wait.
+func (c *Client) logRetry(ctx context.Context, url string, attempt, status int, wait time.Duration, err error) {
+	attrs := []slog.Attr{
+		slog.String("url", url),
+		slog.Int("attempt", attempt),
+		slog.Duration("wait", wait),
+	}
+	if status != 0 {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	c.log(ctx, c.LogLevels.Retry, "luminati request retried", attrs...)
+}
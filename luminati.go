@@ -6,10 +6,13 @@ package luminati
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/ainsleyclark/redigo"
+	"github.com/lacuna-seo/luminati/schema"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
 	"net/url"
@@ -26,6 +29,64 @@ type Client struct {
 	BaseURL     string
 	CacheExpiry time.Duration
 	HasCache    bool
+	// CacheTimeout limits each cache operation, zero for no
+	// limit. A cache that times out is treated as a miss.
+	CacheTimeout time.Duration
+	// CacheBreaker, if set, skips the cache while it is
+	// failing so a dead cache does not add latency to every
+	// lookup. NewWithCache sets one opening after
+	// DefaultCacheBreakerFailures failures in a row.
+	CacheBreaker *Breaker
+	// OnCacheError is called with the options and every cache
+	// failure, such as an unreachable Redis or an entry that
+	// could not be decoded, see Meta.CacheErrors.
+	OnCacheError func(o Options, err *CacheError)
+	// Breaker, if set, stops requests to the Luminati API
+	// while it is failing, so lookups fail fast with a
+	// *BreakerError instead of waiting on a dead upstream.
+	// Requests that fail, time out or respond with a 429 or
+	// 5xx status are failures.
+	Breaker *Breaker
+	// StaleExpiry, if set with a cache, keeps a copy of every
+	// cached result for this long, which is served instead
+	// of a BreakerError while the Breaker is open, see
+	// Meta.Stale.
+	StaleExpiry time.Duration
+	// OnDrift is called with the options and the schema drift
+	// of every response from the Luminati API that differs
+	// from the schema, see Meta.Drift.
+	OnDrift func(o Options, drift []schema.Drift)
+	// Limiter, if set, is waited on before every request to
+	// the Luminati API. Results served from the cache are not
+	// limited.
+	Limiter Limiter
+	// Metrics, if set, receives measurements of every lookup,
+	// see the metrics package for a Prometheus adapter.
+	Metrics Metrics
+	// Retries is the number of times a request to the
+	// Luminati API is retried when it fails or responds with
+	// a 429 or 5xx status, waiting RetryWait multiplied by
+	// the attempt between each. Zero disables retries.
+	Retries   int
+	RetryWait time.Duration
+	// TracerProvider creates the OpenTelemetry spans of every
+	// lookup, defaulting to the global provider. Spans are
+	// children of the span in the context passed to a lookup.
+	TracerProvider trace.TracerProvider
+	// Logger, if set, receives structured events of every
+	// lookup, such as cache misses, retries and failures, at
+	// the LogLevels. The proxy credentials are never logged.
+	Logger    *slog.Logger
+	LogLevels LogLevels
+	proxy     *url.URL
+}
+
+// Limiter rate limits requests to the Luminati API, it is
+// implemented by *rate.Limiter from golang.org/x/time/rate.
+type Limiter interface {
+	// Wait blocks until a request may be made, returning an
+	// error if the context is done first.
+	Wait(ctx context.Context) error
 }
 
 // KeywordFinder defines the methods used for finding Serp data
@@ -61,6 +122,9 @@ const (
 	DefaultCacheExpiry = 8 * time.Hour
 	// PrefixCacheKey is the string prepended before the cache key.
 	PrefixCacheKey = "luminati-client"
+	// DefaultRetryWait is the wait before the first retry of
+	// a request, see Client.Retries.
+	DefaultRetryWait = time.Second
 )
 
 var (
@@ -82,8 +146,12 @@ func New(uri string) (*Client, error) {
 	}
 
 	client := &Client{
-		bodyReader: io.ReadAll,
-		BaseURL:    "http://www.google.com/search",
+		bodyReader:   io.ReadAll,
+		BaseURL:      "http://www.google.com/search",
+		RetryWait:    DefaultRetryWait,
+		CacheTimeout: DefaultCacheTimeout,
+		LogLevels:    DefaultLogLevels,
+		proxy:        proxy,
 		client: &http.Client{
 			Timeout: HTTPTimeout,
 			Transport: &http.Transport{
@@ -114,9 +182,23 @@ func NewWithCache(uri string, cache redigo.Store, cacheExpiry time.Duration) (*C
 	c.cache = cache
 	c.CacheExpiry = cacheExpiry
 	c.HasCache = true
+	c.CacheBreaker = NewBreaker(DefaultCacheBreakerFailures, DefaultCacheBreakerOpen)
 	return c, nil
 }
 
+// Transport returns the http.RoundTripper used by the client
+// to communicate with the Luminati API.
+func (c *Client) Transport() http.RoundTripper {
+	return c.client.Transport
+}
+
+// SetTransport replaces the http.RoundTripper used by the
+// client, such as a Recorder wrapping the existing
+// Transport.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	c.client.Transport = rt
+}
+
 // JSON Retrieves json from the search and returns a return struct
 // after processing.
 //
@@ -126,11 +208,29 @@ func (c *Client) JSON(ctx context.Context, o Options) (Serps, Meta, error) {
 	// For request/response times.
 	now := time.Now()
 
+	// Report the lookup once it has finished.
+	var err error
+	metric := RequestMetric{Format: "json"}
+	defer func() {
+		metric.Duration = time.Since(now)
+		c.observe(o, metric)
+		c.logFinished(ctx, o, metric, err)
+	}()
+
+	// Trace the lookup, recording the error once it has finished.
+	ctx, span := c.startSpan(ctx, "luminati.JSON")
+	defer func() { endSpan(span, err) }()
+
 	// Check the options are valid and assign defaults.
-	err := o.Validate()
+	err = o.Validate()
 	if err != nil {
+		metric.Error = ErrorValidation
 		return Serps{}, Meta{RequestTime: now}, err
 	}
+	span.SetAttributes(o.attributes()...)
+	c.log(ctx, c.LogLevels.Request, "luminati lookup started", o.logAttrs(metric.Format)...)
+
+	defer c.inFlight(o)()
 
 	// Setup the return meta.
 	meta := Meta{
@@ -144,34 +244,42 @@ func (c *Client) JSON(ctx context.Context, o Options) (Serps, Meta, error) {
 	// Try and retrieve in cache.
 	if c.HasCache {
 		var s Serps
-		err = c.cache.Get(ctx, meta.CacheKey, &s)
-		if err == nil {
-			return s, meta, err
+		if c.cacheGet(ctx, o, &meta, meta.CacheKey, &s) {
+			metric.Source = SourceCache
+			return s, meta, nil
 		}
 	}
 
 	// Obtain the response from either cache or the API.
-	buf, err := c.fromLuminati(ctx, meta.RequestURL)
+	metric.Source = SourceUpstream
+	buf, err := c.fromLuminati(ctx, o, meta.RequestURL)
+	if errors.Is(err, ErrBreakerOpen) && c.StaleExpiry > 0 && c.HasCache {
+		var s Serps
+		if c.cacheGet(ctx, o, &meta, staleKey(meta.CacheKey), &s) {
+			metric.Source = SourceStale
+			meta.Stale = true
+			err = nil
+			return s, meta, nil
+		}
+	}
 	if err != nil {
+		metric.Error = upstreamError(err)
 		return Serps{}, meta, err
 	}
 	meta.Body = string(buf)
+	metric.Bytes = len(buf)
 
-	// Unmarshal into a response struct.
-	res := response{}
-	err = json.Unmarshal(buf, &res)
+	// Check the response against the schema and get Serp
+	// data from it.
+	serps, err := c.parse(ctx, o, &meta, buf)
 	if err != nil {
-		return Serps{}, meta, errors.Wrap(err, "error unmarshalling luminati response")
+		metric.Error = ErrorParse
+		return Serps{}, meta, err
 	}
 
-	// Get Serp data from the response.
-	serps, err := res.ToSerps(buf)
-
 	// Store in cache
 	if c.HasCache && len(serps.Organic) > 0 {
-		_ = c.cache.Set(context.Background(), meta.CacheKey, serps, redigo.Options{
-			Expiration: c.CacheExpiry,
-		})
+		c.cacheSet(ctx, o, &meta, serps)
 	}
 
 	return serps, meta, err
@@ -186,11 +294,29 @@ func (c *Client) HTML(ctx context.Context, o Options) (string, Meta, error) {
 	// For request/response times.
 	now := time.Now()
 
+	// Report the lookup once it has finished.
+	var err error
+	metric := RequestMetric{Format: "html"}
+	defer func() {
+		metric.Duration = time.Since(now)
+		c.observe(o, metric)
+		c.logFinished(ctx, o, metric, err)
+	}()
+
+	// Trace the lookup, recording the error once it has finished.
+	ctx, span := c.startSpan(ctx, "luminati.HTML")
+	defer func() { endSpan(span, err) }()
+
 	// Check the options are valid and assign defaults.
-	err := o.Validate()
+	err = o.Validate()
 	if err != nil {
+		metric.Error = ErrorValidation
 		return "", Meta{RequestTime: now}, err
 	}
+	span.SetAttributes(o.attributes()...)
+	c.log(ctx, c.LogLevels.Request, "luminati lookup started", o.logAttrs(metric.Format)...)
+
+	defer c.inFlight(o)()
 
 	// Set html to true for options and set the
 	// query for json to be false.
@@ -212,58 +338,187 @@ func (c *Client) HTML(ctx context.Context, o Options) (string, Meta, error) {
 	// Try and retrieve in cache.
 	if c.HasCache {
 		var html string
-		err = c.cache.Get(context.Background(), meta.CacheKey, &html)
-		if err == nil {
+		if c.cacheGet(ctx, o, &meta, meta.CacheKey, &html) {
 			wasCached = true
-			return html, meta, err
+			metric.Source = SourceCache
+			return html, meta, nil
 		}
 	}
 
 	// Obtain the response from either cache or the API.
-	html, err := c.fromLuminati(ctx, meta.RequestURL)
+	metric.Source = SourceUpstream
+	html, err := c.fromLuminati(ctx, o, meta.RequestURL)
+	if errors.Is(err, ErrBreakerOpen) && c.StaleExpiry > 0 && c.HasCache {
+		var stale string
+		if c.cacheGet(ctx, o, &meta, staleKey(meta.CacheKey), &stale) {
+			wasCached = true
+			metric.Source = SourceStale
+			meta.Stale = true
+			err = nil
+			return stale, meta, nil
+		}
+	}
 	if err != nil {
+		metric.Error = upstreamError(err)
 		return "", meta.process(), err
 	}
 	meta.Body = string(html)
+	metric.Bytes = len(html)
 
 	// Store in cache
 	if c.HasCache {
-		_ = c.cache.Set(context.Background(), meta.CacheKey, html, redigo.Options{
-			Expiration: c.CacheExpiry,
-		})
+		c.cacheSet(ctx, o, &meta, html)
 	}
 
 	return string(html), meta, nil
 }
 
 // fromLuminati obtains the response data from the luminati API
-// if there is nothing stored in the cache.
+// if there is nothing stored in the cache, retrying failed
+// requests up to Client.Retries times.
 //
 // Returns errors.INTERNAL if the request could not be created, the
 // request failed, the body could not be read or the cache
 // could not be set.
-func (c *Client) fromLuminati(ctx context.Context, url string) ([]byte, error) {
+func (c *Client) fromLuminati(ctx context.Context, o Options, url string) ([]byte, error) {
+	ctx, span := c.startSpan(ctx, "luminati.upstream")
+	attempt := 0
+	var err error
+	defer func() {
+		span.SetAttributes(attrAttempts.Int(attempt + 1))
+		endSpan(span, err)
+	}()
+
+	for ; ; attempt++ {
+		if c.Breaker != nil && !c.Breaker.Allow() {
+			err = &BreakerError{Until: c.Breaker.OpenUntil()}
+			return nil, err
+		}
+
+		var (
+			buf    []byte
+			status int
+			retry  bool
+		)
+		buf, status, retry, err = c.attempt(ctx, attempt+1, url)
+		c.report(ctx, retry, err)
+		if !retry || attempt >= c.Retries {
+			if status >= http.StatusBadRequest {
+				c.log(ctx, c.LogLevels.Failure, "luminati request failed",
+					slog.String("url", url), slog.Int("attempt", attempt+1), slog.Int("status", status))
+			}
+			return buf, err
+		}
+
+		if c.Metrics != nil {
+			c.Metrics.Retry(o.Country, o.device())
+		}
+
+		d := time.Duration(attempt+1) * c.RetryWait
+		c.logRetry(ctx, url, attempt+1, status, d, err)
+
+		wait := time.NewTimer(d)
+		select {
+		case <-wait.C:
+		case <-ctx.Done():
+			wait.Stop()
+			return buf, err
+		}
+	}
+}
+
+// report tells the Breaker, if set, the outcome of an
+// attempt. Attempts that failed because the context is done
+// are not reported.
+func (c *Client) report(ctx context.Context, retry bool, err error) {
+	switch {
+	case c.Breaker == nil || (err != nil && ctx.Err() != nil):
+		return
+	case err != nil || retry:
+		c.Breaker.Failure()
+	default:
+		c.Breaker.Success()
+	}
+}
+
+// attempt makes a single request to the luminati API,
+// returning the body and status of the response. Retry is
+// true if the request failed for a reason other than the
+// context, or the API responded with a 429 or 5xx status.
+func (c *Client) attempt(ctx context.Context, n int, url string) (buf []byte, status int, retry bool, err error) {
+	ctx, span := c.startSpan(ctx, "luminati.request", attrAttempt.Int(n))
+	defer func() { endSpan(span, err) }()
+
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(ctx); err != nil {
+			return nil, 0, false, errors.Wrap(err, "luminati rate limit wait failed")
+		}
+	}
+
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		return nil, errors.Wrap(err, "error creating request")
+		return nil, 0, false, errors.Wrap(err, "error creating request")
 	}
 	req = req.WithContext(ctx)
 
 	resp, err := c.client.Do(req)
 	if err != nil && err == context.Canceled {
-		return nil, context.Canceled
+		return nil, 0, false, context.Canceled
 	} else if err != nil && strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
-		return nil, ErrClientTimeout
+		return nil, 0, false, ErrClientTimeout
 	} else if err != nil {
-		return nil, errors.Wrap(err, "luminati client request failed")
+		return nil, 0, true, errors.Wrap(err, "luminati client request failed")
 	}
 
 	defer resp.Body.Close()
 
-	buf, err := c.bodyReader(resp.Body)
+	span.SetAttributes(attrStatus.Int(resp.StatusCode))
+	if id := resp.Header.Get(HeaderRequestID); id != "" {
+		span.SetAttributes(attrRequestID.String(id))
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
+	buf, err = c.bodyReader(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "luminati body read failed")
+		return nil, resp.StatusCode, true, errors.Wrap(err, "luminati body read failed")
+	}
+	span.SetAttributes(attrBytes.Int(len(buf)))
+
+	retry = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+
+	return buf, resp.StatusCode, retry, nil
+}
+
+// parse checks the response against the schema, reporting
+// any drift, and gets Serp data from it within a span.
+func (c *Client) parse(ctx context.Context, o Options, meta *Meta, buf []byte) (Serps, error) {
+	_, span := c.startSpan(ctx, "luminati.parse")
+	if span.IsRecording() {
+		if id := requestID(buf); id != "" {
+			span.SetAttributes(attrRequestID.String(id))
+			trace.SpanFromContext(ctx).SetAttributes(attrRequestID.String(id))
+		}
 	}
 
-	return buf, nil
+	meta.Drift, _ = schema.Detect(buf) // Unmarshal errors are returned below
+	span.SetAttributes(attrDrift.Int(len(meta.Drift)))
+	if len(meta.Drift) > 0 {
+		paths := make([]string, len(meta.Drift))
+		for i, d := range meta.Drift {
+			paths[i] = d.Path
+		}
+		c.log(ctx, c.LogLevels.Parse, "luminati schema drift",
+			slog.String("code_version", meta.Drift[0].CodeVersion), slog.Int("drift", len(meta.Drift)),
+			slog.String("paths", strings.Join(paths, ",")))
+	}
+	if len(meta.Drift) > 0 && c.OnDrift != nil {
+		c.OnDrift(o, meta.Drift)
+	}
+
+	serps, err := toSerps(buf)
+	endSpan(span, err)
+
+	return serps, err
 }
@@ -0,0 +1,195 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MatchMode defines how a target URL is compared against
+// the links of organic results.
+type MatchMode int
+
+const (
+	// MatchPrefix matches links on the same host whose path
+	// starts with the target path on a segment boundary. It
+	// is the mode used by Serps.CheckURL.
+	MatchPrefix MatchMode = iota
+	// MatchExact matches links with the same host, path and
+	// query as the target.
+	MatchExact
+	// MatchHost matches links on the same host as the target.
+	MatchHost
+	// MatchDomain matches links with the same registrable
+	// domain as the target, as defined by the public suffix
+	// list, e.g. support.apple.com matches apple.com.
+	MatchDomain
+	// MatchSubdomain matches links on the target host or any
+	// of its subdomains.
+	MatchSubdomain
+	// MatchRegex matches the raw link against the target as a
+	// regular expression.
+	MatchRegex
+)
+
+// Matcher determines if an organic link belongs to a target.
+type Matcher interface {
+	// Match reports whether the link matches.
+	Match(link string) bool
+}
+
+type (
+	// urlMatcher is the Matcher returned by NewMatcher for
+	// every mode except MatchRegex.
+	urlMatcher struct {
+		mode   MatchMode
+		target normalisedURL
+	}
+	// regexMatcher is the Matcher returned by NewMatcher for
+	// MatchRegex.
+	regexMatcher struct {
+		re *regexp.Regexp
+	}
+	// normalisedURL is a URL reduced to the parts used for
+	// comparison.
+	normalisedURL struct {
+		host  string
+		path  string
+		query string
+	}
+)
+
+// NewMatcher creates a Matcher for the target in the given
+// mode. Targets without a scheme such as "apple.com" are
+// accepted. Returns an error if the target could not be
+// parsed or the regular expression is invalid.
+func NewMatcher(target string, mode MatchMode) (Matcher, error) {
+	if mode == MatchRegex {
+		re, err := regexp.Compile(target)
+		if err != nil {
+			return nil, errors.Wrap(err, "error compiling match expression")
+		}
+		return &regexMatcher{re: re}, nil
+	}
+	n, err := normaliseURL(target)
+	if err != nil {
+		return nil, err
+	}
+	if n.host == "" {
+		return nil, errors.New("error: match target has no host")
+	}
+	return &urlMatcher{mode: mode, target: n}, nil
+}
+
+// Match reports whether the link matches the target.
+func (m *urlMatcher) Match(link string) bool {
+	n, err := normaliseURL(link)
+	if err != nil {
+		return false
+	}
+	t := m.target
+	switch m.mode {
+	case MatchExact:
+		return n == t
+	case MatchHost:
+		return n.host == t.host
+	case MatchDomain:
+		return registrableDomain(n.host) == registrableDomain(t.host)
+	case MatchSubdomain:
+		return n.host == t.host || strings.HasSuffix(n.host, "."+t.host)
+	default:
+		return n.host == t.host && (t.path == "" || n.path == t.path || strings.HasPrefix(n.path, t.path+"/"))
+	}
+}
+
+// Match reports whether the link matches the expression.
+func (m *regexMatcher) Match(link string) bool {
+	return m.re.MatchString(link)
+}
+
+// NormaliseURL returns the canonical form of a link used for
+// matching. The scheme is dropped, the host is lowercased,
+// stripped of www. and the default port and converted to
+// punycode, percent-encoding is canonicalised and trailing
+// slashes are removed.
+func NormaliseURL(link string) (string, error) {
+	n, err := normaliseURL(link)
+	if err != nil {
+		return "", err
+	}
+	s := n.host + n.path
+	if n.query != "" {
+		s += "?" + n.query
+	}
+	return s, nil
+}
+
+// RegistrableDomain returns the registrable domain (eTLD+1)
+// of a link, e.g. "bbc.co.uk" for "https://www.bbc.co.uk/news".
+// An empty string is returned if the link could not be
+// parsed.
+func RegistrableDomain(link string) string {
+	n, err := normaliseURL(link)
+	if err != nil {
+		return ""
+	}
+	return registrableDomain(n.host)
+}
+
+// normaliseURL parses and normalises a link, see
+// NormaliseURL.
+func normaliseURL(link string) (normalisedURL, error) {
+	link = strings.TrimSpace(link)
+	if !strings.Contains(link, "://") {
+		link = "http://" + strings.TrimPrefix(link, "//")
+	}
+
+	uri, err := url.Parse(link)
+	if err != nil {
+		return normalisedURL{}, errors.Wrap(err, "error parsing url")
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(uri.Hostname()), ".")
+	if ascii, err := idna.Lookup.ToASCII(host); err == nil {
+		host = ascii
+	}
+	host = strings.TrimPrefix(host, "www.")
+	if port := uri.Port(); port != "" && port != "80" && port != "443" {
+		host = net.JoinHostPort(host, port)
+	}
+
+	path := strings.TrimRight((&url.URL{Path: uri.Path}).EscapedPath(), "/")
+
+	query := ""
+	if uri.RawQuery != "" {
+		query = uri.Query().Encode()
+	}
+
+	return normalisedURL{host: host, path: path, query: query}, nil
+}
+
+// registrableDomain returns the eTLD+1 of a normalised host,
+// or the host itself if it is an IP address or a public
+// suffix.
+func registrableDomain(host string) string {
+	h, _, err := net.SplitHostPort(host)
+	if err == nil {
+		host = h
+	}
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
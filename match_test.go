@@ -0,0 +1,146 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+func (t *LuminatiTestSuite) TestNewMatcher() {
+	tt := map[string]struct {
+		target string
+		mode   MatchMode
+		want   interface{}
+	}{
+		"Bad Regex": {
+			"[a-z",
+			MatchRegex,
+			"error compiling match expression",
+		},
+		"Bad URL": {
+			"postgres://user:abc{",
+			MatchHost,
+			"error parsing url",
+		},
+		"No Host": {
+			"https://",
+			MatchHost,
+			"match target has no host",
+		},
+		"Success": {
+			"apple.com",
+			MatchHost,
+			nil,
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got, err := NewMatcher(test.target, test.mode)
+			if err != nil {
+				t.Contains(err.Error(), test.want)
+				return
+			}
+			t.NotNil(got)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestMatcher_Match() {
+	tt := map[string]struct {
+		target string
+		mode   MatchMode
+		link   string
+		want   bool
+	}{
+		"Prefix Lookalike":       {"apple.com", MatchPrefix, "https://pineapple.com", false},
+		"Prefix Suffix Domain":   {"apple.com", MatchPrefix, "https://apple.com.evil.io", false},
+		"Prefix WWW":             {"https://www.apple.com", MatchPrefix, "https://apple.com/mac/", true},
+		"Prefix Scheme":          {"http://apple.com", MatchPrefix, "https://www.apple.com", true},
+		"Prefix Path":            {"apple.com/mac", MatchPrefix, "https://www.apple.com/mac/pro/", true},
+		"Prefix Path Boundary":   {"apple.com/mac", MatchPrefix, "https://www.apple.com/macbook/", false},
+		"Prefix Bad Link":        {"apple.com", MatchPrefix, "postgres://user:abc{", false},
+		"Exact Trailing Slash":   {"https://apple.com/mac", MatchExact, "https://www.apple.com/mac/", true},
+		"Exact Percent Encoding": {"https://apple.com/caf%C3%A9", MatchExact, "https://apple.com/café", true},
+		"Exact Query Order":      {"https://apple.com/?b=2&a=1", MatchExact, "https://apple.com?a=1&b=2", true},
+		"Exact Path":             {"https://apple.com/mac", MatchExact, "https://apple.com/mac/pro", false},
+		"Host":                   {"apple.com", MatchHost, "https://apple.com/mac", true},
+		"Host Subdomain":         {"apple.com", MatchHost, "https://support.apple.com", false},
+		"Host Port":              {"apple.com", MatchHost, "https://apple.com:443/mac", true},
+		"Host IDN":               {"https://bücher.de", MatchHost, "https://xn--bcher-kva.de/", true},
+		"Host Case":              {"APPLE.com", MatchHost, "https://Apple.COM", true},
+		"Domain Subdomain":       {"apple.com", MatchDomain, "https://support.apple.com", true},
+		"Domain ccTLD":           {"https://www.bbc.co.uk", MatchDomain, "https://news.bbc.co.uk/sport", true},
+		"Domain Other ccTLD":     {"bbc.co.uk", MatchDomain, "https://bbc.com", false},
+		"Domain Shared Suffix":   {"foo.github.io", MatchDomain, "https://bar.github.io", false},
+		"Subdomain":              {"apple.com", MatchSubdomain, "https://support.apple.com", true},
+		"Subdomain Root":         {"support.apple.com", MatchSubdomain, "https://apple.com", false},
+		"Subdomain Lookalike":    {"apple.com", MatchSubdomain, "https://pineapple.com", false},
+		"Regex":                  {`^https://(www\.)?apple\.com/mac`, MatchRegex, "https://www.apple.com/macbook", true},
+		"Regex No Match":         {`^https://(www\.)?apple\.com/mac`, MatchRegex, "https://apple.co.uk/macbook", false},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			m, err := NewMatcher(test.target, test.mode)
+			t.NoError(err)
+			t.Equal(test.want, m.Match(test.link))
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestNormaliseURL() {
+	tt := map[string]struct {
+		input string
+		want  interface{}
+	}{
+		"Error": {
+			"postgres://user:abc{",
+			"error parsing url",
+		},
+		"No Scheme": {
+			"WWW.Apple.com/Mac/",
+			"apple.com/Mac",
+		},
+		"Port": {
+			"http://apple.com:8080",
+			"apple.com:8080",
+		},
+		"IDN": {
+			"https://www.bücher.de/caf%c3%a9",
+			"xn--bcher-kva.de/caf%C3%A9",
+		},
+		"Query": {
+			"https://apple.com/?b=2&a=1#top",
+			"apple.com?a=1&b=2",
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got, err := NormaliseURL(test.input)
+			if err != nil {
+				t.Contains(err.Error(), test.want)
+				return
+			}
+			t.Equal(test.want, got)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestRegistrableDomain() {
+	tt := map[string]struct {
+		input string
+		want  string
+	}{
+		"Error":     {"postgres://user:abc{", ""},
+		"WWW":       {"https://WWW.Apple.com:443/mac/", "apple.com"},
+		"Subdomain": {"https://support.apple.com", "apple.com"},
+		"ccTLD":     {"https://www.bbc.co.uk/news", "bbc.co.uk"},
+		"IP":        {"http://127.0.0.1:8080/", "127.0.0.1"},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, RegistrableDomain(test.input))
+		})
+	}
+}
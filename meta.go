@@ -5,6 +5,7 @@
 package luminati
 
 import (
+	"github.com/lacuna-seo/luminati/schema"
 	"time"
 )
 
@@ -31,6 +32,17 @@ type Meta struct {
 	WasCached bool
 	// Body is the request body sent back from Luminati.
 	Body string
+	// Drift are the differences between the response and
+	// the schema, such as new blocks, missing fields or type
+	// changes. It's empty for cached and HTML requests.
+	Drift []schema.Drift
+	// CacheErrors are the failures of the cache during the
+	// lookup, which was then made without it. Cache misses
+	// are not errors.
+	CacheErrors []*CacheError
+	// Stale is true if the result is a stale copy from the
+	// cache, served because the Client.Breaker is open.
+	Stale bool
 }
 
 // process adds the ResponseTime & LatencyTime to the
@@ -42,5 +54,18 @@ func (m *Meta) process() Meta {
 		RequestTime:  m.RequestTime.UTC(),
 		ResponseTime: time.Now().UTC(),
 		LatencyTime:  time.Since(m.RequestTime).Round(time.Microsecond),
+		Drift:        m.Drift,
+		CacheErrors:  m.CacheErrors,
+		Stale:        m.Stale,
 	}
 }
+
+// SummariseDrift groups the schema drift across a batch of
+// lookups, sorted by the number of occurrences.
+func SummariseDrift(metas []Meta) []schema.DriftSummary {
+	var drift []schema.Drift
+	for _, m := range metas {
+		drift = append(drift, m.Drift...)
+	}
+	return schema.Summarise(drift)
+}
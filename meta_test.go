@@ -4,7 +4,11 @@
 
 package luminati
 
-import "time"
+import (
+	"time"
+
+	"github.com/lacuna-seo/luminati/schema"
+)
 
 func (t *LuminatiTestSuite) TestMeta_Process() {
 	meta := Meta{
@@ -18,3 +22,11 @@ func (t *LuminatiTestSuite) TestMeta_Process() {
 	t.Equal("https://google.com", got.RequestURL)
 	t.WithinDuration(time.Now(), got.ResponseTime, 100*time.Millisecond)
 }
+
+func (t *LuminatiTestSuite) TestSummariseDrift() {
+	drift := schema.Drift{Kind: schema.DriftNewBlock, Path: "shopping", CodeVersion: "1.6"}
+	got := SummariseDrift([]Meta{{Drift: []schema.Drift{drift}}, {}, {Drift: []schema.Drift{drift}}})
+	t.Equal([]schema.DriftSummary{
+		{Kind: schema.DriftNewBlock, Path: "shopping", Count: 2, CodeVersions: []string{"1.6"}},
+	}, got)
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const (
+	// SourceCache is the RequestMetric source of lookups
+	// served from the cache.
+	SourceCache = "cache"
+	// SourceUpstream is the RequestMetric source of lookups
+	// made to the Luminati API.
+	SourceUpstream = "upstream"
+	// SourceStale is the RequestMetric source of lookups
+	// served from a stale cache entry while the Client.Breaker
+	// is open.
+	SourceStale = "stale"
+)
+
+const (
+	// ErrorValidation is the error class of lookups with
+	// invalid options.
+	ErrorValidation = "validation"
+	// ErrorTimeout is the error class of lookups that
+	// exceeded their deadline.
+	ErrorTimeout = "timeout"
+	// ErrorCancelled is the error class of lookups whose
+	// context was cancelled.
+	ErrorCancelled = "cancelled"
+	// ErrorUpstream is the error class of lookups where the
+	// request to the Luminati API or reading its body failed.
+	ErrorUpstream = "upstream"
+	// ErrorParse is the error class of lookups where the
+	// response could not be parsed.
+	ErrorParse = "parse"
+	// ErrorBreaker is the error class of lookups rejected by
+	// the Client.Breaker.
+	ErrorBreaker = "breaker"
+)
+
+type (
+	// Metrics receives measurements of the lookups made by
+	// a Client, see Client.Metrics. Measurements are
+	// labelled by country and device only, keywords are
+	// never reported to keep cardinality low.
+	Metrics interface {
+		// InFlight is called with 1 when a lookup starts and
+		// -1 when it finishes.
+		InFlight(country, device string, delta int)
+		// Request is called when a lookup finishes.
+		Request(m RequestMetric)
+		// Cache is called with the result of every cache
+		// lookup.
+		Cache(country, device string, hit bool)
+		// Retry is called before every retried request to
+		// the Luminati API, see Client.Retries.
+		Retry(country, device string)
+	}
+	// RequestMetric is a single finished lookup.
+	RequestMetric struct {
+		Country string
+		Device  string
+		// Format is either "json" or "html".
+		Format string
+		// Source is SourceCache, SourceUpstream or
+		// SourceStale, empty if the options failed
+		// validation.
+		Source string
+		// Error is the class of the error, such as
+		// ErrorTimeout, empty if the lookup succeeded.
+		Error string
+		// Duration is the time the lookup took.
+		Duration time.Duration
+		// Bytes is the size of the response from the
+		// Luminati API, zero for cached lookups.
+		Bytes int
+	}
+)
+
+// inFlight reports the start of a lookup if metrics are set,
+// returning a func that reports its end.
+func (c *Client) inFlight(o Options) func() {
+	if c.Metrics == nil {
+		return func() {}
+	}
+	c.Metrics.InFlight(o.Country, o.device(), 1)
+	return func() { c.Metrics.InFlight(o.Country, o.device(), -1) }
+}
+
+// observe reports a finished lookup if metrics are set.
+func (c *Client) observe(o Options, m RequestMetric) {
+	if c.Metrics == nil {
+		return
+	}
+	m.Country = o.Country
+	m.Device = o.device()
+	c.Metrics.Request(m)
+}
+
+// observeCache reports a cache lookup if metrics are set.
+func (c *Client) observeCache(o Options, hit bool) {
+	if c.Metrics == nil {
+		return
+	}
+	c.Metrics.Cache(o.Country, o.device(), hit)
+}
+
+// upstreamError returns the error class of an error returned
+// by fromLuminati.
+func upstreamError(err error) string {
+	switch {
+	case err == ErrClientTimeout || errors.Is(err, context.DeadlineExceeded):
+		return ErrorTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorCancelled
+	case errors.Is(err, ErrBreakerOpen):
+		return ErrorBreaker
+	}
+	return ErrorUpstream
+}
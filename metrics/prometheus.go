@@ -0,0 +1,146 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package metrics adapts luminati.Metrics to Prometheus.
+package metrics
+
+import (
+	"github.com/lacuna-seo/luminati"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace is the prefix of every metric name when
+// no namespace is passed to NewPrometheus.
+const DefaultNamespace = "luminati"
+
+// Prometheus implements luminati.Metrics with Prometheus
+// collectors. Every metric is labelled by country and
+// device.
+//
+// The cache hit ratio is obtained from cache_lookups_total:
+//
+//	sum(rate(luminati_cache_lookups_total{result="hit"}[5m]))
+//	  / sum(rate(luminati_cache_lookups_total[5m]))
+type Prometheus struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	bytes    *prometheus.HistogramVec
+	cache    *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+	inFlight *prometheus.GaugeVec
+	reg      prometheus.Registerer
+	ns       string
+}
+
+var _ luminati.Metrics = (*Prometheus)(nil)
+
+// NewPrometheus creates the collectors under the namespace
+// and registers them with the registerer, such as
+// prometheus.DefaultRegisterer. An error is returned if
+// they are already registered.
+func NewPrometheus(reg prometheus.Registerer, namespace string) (*Prometheus, error) {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+
+	labels := []string{"country", "device"}
+	p := &Prometheus{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Lookups by format, source, outcome and error class.",
+		}, append([]string{"format", "source", "outcome", "error"}, labels...)),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of lookups by format and source, cache or upstream.",
+			Buckets:   []float64{.005, .025, .1, .5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+		}, append([]string{"format", "source"}, labels...)),
+		bytes: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "response_bytes",
+			Help:      "Size of responses from the Luminati API.",
+			Buckets:   prometheus.ExponentialBuckets(16*1024, 2, 8),
+		}, append([]string{"format"}, labels...)),
+		cache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "Cache lookups by result, hit or miss.",
+		}, append([]string{"result"}, labels...)),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Retried requests to the Luminati API.",
+		}, labels),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "in_flight_requests",
+			Help:      "Lookups currently in progress.",
+		}, labels),
+		reg: reg,
+		ns:  namespace,
+	}
+
+	for _, c := range []prometheus.Collector{p.requests, p.latency, p.bytes, p.cache, p.retries, p.inFlight} {
+		if err := reg.Register(c); err != nil {
+			return nil, errors.Wrap(err, "error registering luminati metrics")
+		}
+	}
+
+	return p, nil
+}
+
+// InFlight implements luminati.Metrics.
+func (p *Prometheus) InFlight(country, device string, delta int) {
+	p.inFlight.WithLabelValues(country, device).Add(float64(delta))
+}
+
+// Request implements luminati.Metrics.
+func (p *Prometheus) Request(m luminati.RequestMetric) {
+	outcome := "success"
+	if m.Error != "" {
+		outcome = "error"
+	}
+	p.requests.WithLabelValues(m.Format, m.Source, outcome, m.Error, m.Country, m.Device).Inc()
+	if m.Source != "" {
+		p.latency.WithLabelValues(m.Format, m.Source, m.Country, m.Device).Observe(m.Duration.Seconds())
+	}
+	if m.Bytes > 0 {
+		p.bytes.WithLabelValues(m.Format, m.Country, m.Device).Observe(float64(m.Bytes))
+	}
+}
+
+// Cache implements luminati.Metrics.
+func (p *Prometheus) Cache(country, device string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	p.cache.WithLabelValues(result, country, device).Inc()
+}
+
+// Retry implements luminati.Metrics.
+func (p *Prometheus) Retry(country, device string) {
+	p.retries.WithLabelValues(country, device).Inc()
+}
+
+// Breaker registers a breaker_state gauge reporting the state
+// of the breaker, 0 closed, 1 open and 2 half-open, labelled
+// by name, such as "upstream" for Client.Breaker or "cache"
+// for Client.CacheBreaker.
+func (p *Prometheus) Breaker(name string, b *luminati.Breaker) error {
+	g := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   p.ns,
+		Name:        "breaker_state",
+		Help:        "State of the circuit breaker, 0 closed, 1 open and 2 half-open.",
+		ConstLabels: prometheus.Labels{"breaker": name},
+	}, func() float64 {
+		return float64(b.State())
+	})
+	if err := p.reg.Register(g); err != nil {
+		return errors.Wrap(err, "error registering luminati breaker")
+	}
+	return nil
+}
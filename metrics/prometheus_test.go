@@ -0,0 +1,96 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"github.com/lacuna-seo/luminati"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/suite"
+	"strings"
+	"testing"
+	"time"
+)
+
+// MetricsTestSuite defines the helper used for metrics testing.
+type MetricsTestSuite struct {
+	suite.Suite
+}
+
+// TestMetrics asserts testing has begun.
+func TestMetrics(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
+
+func (t *MetricsTestSuite) TestNewPrometheus() {
+	reg := prometheus.NewRegistry()
+
+	_, err := NewPrometheus(reg, "")
+	t.NoError(err)
+
+	_, err = NewPrometheus(reg, "")
+	t.Contains(err.Error(), "error registering luminati metrics")
+
+	_, err = NewPrometheus(reg, "serps")
+	t.NoError(err)
+}
+
+func (t *MetricsTestSuite) TestPrometheus() {
+	reg := prometheus.NewRegistry()
+	p, err := NewPrometheus(reg, "")
+	t.NoError(err)
+
+	p.InFlight("uk", luminati.DeviceMobile, 1)
+	p.InFlight("uk", luminati.DeviceMobile, 1)
+	p.InFlight("uk", luminati.DeviceMobile, -1)
+	p.Cache("uk", luminati.DeviceMobile, true)
+	p.Cache("uk", luminati.DeviceMobile, false)
+	p.Cache("uk", luminati.DeviceMobile, false)
+	p.Retry("us", luminati.DeviceDesktop)
+	p.Request(luminati.RequestMetric{Country: "uk", Device: luminati.DeviceMobile, Format: "json", Source: luminati.SourceUpstream, Duration: time.Second, Bytes: 1024})
+	p.Request(luminati.RequestMetric{Country: "uk", Device: luminati.DeviceMobile, Format: "json", Source: luminati.SourceCache})
+	p.Request(luminati.RequestMetric{Country: "uk", Device: luminati.DeviceMobile, Format: "html", Source: luminati.SourceUpstream, Error: luminati.ErrorTimeout})
+	p.Request(luminati.RequestMetric{Device: luminati.DeviceMobile, Format: "json", Error: luminati.ErrorValidation})
+
+	t.Equal(1.0, testutil.ToFloat64(p.inFlight.WithLabelValues("uk", luminati.DeviceMobile)))
+	t.Equal(1.0, testutil.ToFloat64(p.cache.WithLabelValues("hit", "uk", luminati.DeviceMobile)))
+	t.Equal(2.0, testutil.ToFloat64(p.cache.WithLabelValues("miss", "uk", luminati.DeviceMobile)))
+	t.Equal(1.0, testutil.ToFloat64(p.retries.WithLabelValues("us", luminati.DeviceDesktop)))
+
+	want := `
+# HELP luminati_requests_total Lookups by format, source, outcome and error class.
+# TYPE luminati_requests_total counter
+luminati_requests_total{country="",device="mobile",error="validation",format="json",outcome="error",source=""} 1
+luminati_requests_total{country="uk",device="mobile",error="",format="json",outcome="success",source="cache"} 1
+luminati_requests_total{country="uk",device="mobile",error="",format="json",outcome="success",source="upstream"} 1
+luminati_requests_total{country="uk",device="mobile",error="timeout",format="html",outcome="error",source="upstream"} 1
+`
+	t.NoError(testutil.GatherAndCompare(reg, strings.NewReader(want), "luminati_requests_total"))
+
+	// Validation failures have no source and are not timed.
+	t.Equal(3, testutil.CollectAndCount(p.latency))
+	t.Equal(1, testutil.CollectAndCount(p.bytes))
+}
+
+func (t *MetricsTestSuite) TestPrometheus_Breaker() {
+	reg := prometheus.NewRegistry()
+	p, err := NewPrometheus(reg, "")
+	t.NoError(err)
+
+	upstream := luminati.NewBreaker(1, time.Minute)
+	t.NoError(p.Breaker("upstream", upstream))
+	t.NoError(p.Breaker("cache", luminati.NewBreaker(1, time.Minute)))
+	err = p.Breaker("upstream", upstream)
+	t.Contains(err.Error(), "error registering luminati breaker")
+
+	upstream.Failure()
+	want := `
+# HELP luminati_breaker_state State of the circuit breaker, 0 closed, 1 open and 2 half-open.
+# TYPE luminati_breaker_state gauge
+luminati_breaker_state{breaker="cache"} 0
+luminati_breaker_state{breaker="upstream"} 1
+`
+	t.NoError(testutil.GatherAndCompare(reg, strings.NewReader(want), "luminati_breaker_state"))
+}
@@ -30,6 +30,15 @@ type Options struct {
 	Desktop bool
 }
 
+const (
+	// DeviceDesktop is the device name used for desktop
+	// results.
+	DeviceDesktop = "desktop"
+	// DeviceMobile is the device name used for mobile
+	// results.
+	DeviceMobile = "mobile"
+)
+
 var (
 	// ErrNoKeywordProvided is returned by validate when no keyword
 	// was provided to the Options struct.
@@ -72,15 +81,20 @@ func (o *Options) cacheKey(html, hasCache bool) string {
 	if !hasCache {
 		return ""
 	}
-	device := "mobile"
-	if o.Desktop {
-		device = "desktop"
-	}
 	format := "json"
 	if html {
 		format = "html"
 	}
-	return fmt.Sprintf("%s-%s-%s-%s-%s", PrefixCacheKey, strings.ToLower(alphaNum(o.Keyword)), o.Country, device, format)
+	return fmt.Sprintf("%s-%s-%s-%s-%s", PrefixCacheKey, strings.ToLower(alphaNum(o.Keyword)), o.Country, o.device(), format)
+}
+
+// device returns the device type the options will obtain
+// serps for, either "desktop" or "mobile".
+func (o *Options) device() string {
+	if o.Desktop {
+		return DeviceDesktop
+	}
+	return DeviceMobile
 }
 
 // getRequestURL returns the URL for the request to Luminati.
@@ -0,0 +1,225 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint
+	"encoding/hex"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// RecorderMode defines how the Recorder treats outgoing
+// requests.
+type RecorderMode int
+
+const (
+	// RecordMode passes requests through to the underlying
+	// transport and writes every exchange to the fixture
+	// directory.
+	RecordMode RecorderMode = iota
+	// ReplayMode serves responses from the fixture directory
+	// and never touches the network.
+	ReplayMode
+)
+
+var (
+	// ErrFixtureNotFound is returned by the Recorder in
+	// ReplayMode when no fixture matches the request.
+	ErrFixtureNotFound = errors.New("error: no fixture recorded for request")
+	// scrubbedHeaders are the request headers removed before
+	// a fixture is written to disk.
+	scrubbedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+)
+
+type (
+	// Recorder is an http.RoundTripper that captures BrightData
+	// exchanges to disk and replays them, so regression
+	// fixtures can be run without network access.
+	Recorder struct {
+		// Dir is the directory fixtures are written to and read
+		// from, such as testdata/fixtures.
+		Dir string
+		// Mode determines if requests are recorded or replayed.
+		Mode RecorderMode
+		// Transport is the underlying http.RoundTripper used
+		// in RecordMode.
+		Transport http.RoundTripper
+	}
+	// Fixture is a single recorded exchange as stored on
+	// disk.
+	Fixture struct {
+		Request  FixtureRequest  `json:"request"`
+		Response FixtureResponse `json:"response"`
+	}
+	// FixtureRequest is the scrubbed request of a Fixture.
+	FixtureRequest struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header"`
+		Body   string      `json:"body,omitempty"`
+	}
+	// FixtureResponse is the recorded response of a Fixture.
+	FixtureResponse struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header"`
+		Body       string      `json:"body"`
+	}
+)
+
+// NewRecorder creates a new Recorder in the given mode. If the
+// transport is nil, http.DefaultTransport is used when
+// recording.
+func NewRecorder(dir string, mode RecorderMode, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{
+		Dir:       dir,
+		Mode:      mode,
+		Transport: transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper. In ReplayMode an error
+// wrapping ErrFixtureNotFound is returned for any request that
+// was not previously recorded.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	fr, err := newFixtureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Mode == ReplayMode {
+		return r.replay(req, fr)
+	}
+	return r.record(req, fr)
+}
+
+// record performs the request and writes the exchange to the
+// fixture directory.
+func (r *Recorder) record(req *http.Request, fr FixtureRequest) (*http.Response, error) {
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading response to record")
+	}
+
+	fixture := Fixture{
+		Request: fr,
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       string(buf),
+		},
+	}
+
+	data, err := json.MarshalIndent(fixture, "", "\t")
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling fixture")
+	}
+
+	err = os.MkdirAll(r.Dir, os.ModePerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating fixture directory")
+	}
+
+	err = os.WriteFile(r.path(fr), data, 0644) //nolint
+	if err != nil {
+		return nil, errors.Wrap(err, "error writing fixture")
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(buf))
+	return resp, nil
+}
+
+// replay reads the fixture matching the request from disk and
+// builds a response from it.
+func (r *Recorder) replay(req *http.Request, fr FixtureRequest) (*http.Response, error) {
+	data, err := os.ReadFile(r.path(fr))
+	if os.IsNotExist(err) {
+		return nil, errors.Wrapf(ErrFixtureNotFound, "%s %s", fr.Method, fr.URL)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "error reading fixture")
+	}
+
+	var fixture Fixture
+	err = json.Unmarshal(data, &fixture)
+	if err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling fixture")
+	}
+
+	if fixture.Request.Method != fr.Method || fixture.Request.URL != fr.URL {
+		return nil, errors.Wrapf(ErrFixtureNotFound, "%s %s", fr.Method, fr.URL)
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(fixture.Response.StatusCode),
+		StatusCode:    fixture.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        fixture.Response.Header,
+		Body:          io.NopCloser(bytes.NewBufferString(fixture.Response.Body)),
+		ContentLength: int64(len(fixture.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// path returns the fixture file name for a request, keyed by
+// the method and scrubbed URL.
+func (r *Recorder) path(fr FixtureRequest) string {
+	h := sha1.Sum([]byte(fr.Method + " " + fr.URL)) //nolint
+	return filepath.Join(r.Dir, hex.EncodeToString(h[:8])+".json")
+}
+
+// newFixtureRequest creates a FixtureRequest from the request
+// with proxy credentials scrubbed. The request body is read
+// and restored so the request can still be sent.
+func newFixtureRequest(req *http.Request) (FixtureRequest, error) {
+	fr := FixtureRequest{
+		Method: req.Method,
+		URL:    scrubURL(req.URL),
+		Header: req.Header.Clone(),
+	}
+	if fr.Header == nil {
+		fr.Header = http.Header{}
+	}
+	for _, h := range scrubbedHeaders {
+		fr.Header.Del(h)
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return fr, nil
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		return FixtureRequest{}, errors.Wrap(err, "error reading request body")
+	}
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(buf))
+	fr.Body = string(buf)
+
+	return fr, nil
+}
+
+// scrubURL returns the string form of the URL without any user
+// credentials.
+func scrubURL(u *url.URL) string {
+	c := *u
+	c.User = nil
+	return c.String()
+}
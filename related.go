@@ -0,0 +1,152 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"github.com/lacuna-seo/luminati/schema"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// FeatureRelated is the BrightData block name for related
+// searches and refinement chips.
+const FeatureRelated = "related"
+
+// DefaultExpandDepth is the number of levels walked by
+// ExpandKeywords when no depth is passed via the
+// ExpandOptions.
+const DefaultExpandDepth = 1
+
+type (
+	// Related is a single related search or refinement chip
+	// as defined in Serps.
+	Related struct {
+		Text string `json:"text"`
+		Link string `json:"url,omitempty"`
+		// Refinement is true for refinement chips, which
+		// BrightData reports as list groups, as opposed to
+		// related searches at the bottom of the page.
+		Refinement bool   `json:"refinement"`
+		Expanded   bool   `json:"expanded,omitempty"`
+		Image      string `json:"image,omitempty"`
+		ImageAlt   string `json:"image_alt,omitempty"`
+		ImageURL   string `json:"image_url,omitempty"`
+		Rank       int    `json:"position"`
+		GlobalRank int    `json:"global_position,omitempty"`
+	}
+	// ExpandOptions configures ExpandKeywords.
+	ExpandOptions struct {
+		// Depth is the number of levels of related searches
+		// to walk, defaults to DefaultExpandDepth.
+		Depth int
+		// MaxKeywords stops the walk once this many keywords
+		// have been found, zero means no limit.
+		MaxKeywords int
+		// ExcludeRefinements skips refinement chips and only
+		// follows related searches.
+		ExcludeRefinements bool
+	}
+	// ExpandedKeyword is a keyword found by ExpandKeywords.
+	ExpandedKeyword struct {
+		Keyword string `json:"keyword"`
+		Depth   int    `json:"depth"`
+		Parent  string `json:"parent"`
+	}
+)
+
+// related transforms the related block of the response.
+func related(items []schema.Related) []Related {
+	var result []Related
+	for _, i := range items {
+		result = append(result, Related{
+			Text:       i.Text,
+			Link:       i.Link,
+			Refinement: i.ListGroup,
+			Expanded:   i.Expanded,
+			Image:      i.Image,
+			ImageAlt:   i.ImageAlt,
+			ImageURL:   i.ImageURL,
+			Rank:       i.Rank,
+			GlobalRank: i.GlobalRank,
+		})
+	}
+	return result
+}
+
+// RelatedSearches returns the text of the related searches,
+// optionally including refinement chips.
+func (s *Serps) RelatedSearches(refinements bool) []string {
+	var searches []string
+	for _, r := range s.Related {
+		if r.Text == "" || (r.Refinement && !refinements) {
+			continue
+		}
+		searches = append(searches, r.Text)
+	}
+	return searches
+}
+
+// ExpandKeywords walks the related searches of the seed
+// options breadth-first through the KeywordFinder, to the
+// configured depth. Every lookup uses the seed's country,
+// device and params. Keywords are deduplicated ignoring case
+// and surrounding whitespace, and the seed itself is not
+// returned.
+//
+// Returns the keywords found so far and an error if any
+// lookup failed.
+func ExpandKeywords(ctx context.Context, kf KeywordFinder, seed Options, opts ExpandOptions) ([]ExpandedKeyword, error) {
+	if opts.Depth <= 0 {
+		opts.Depth = DefaultExpandDepth
+	}
+
+	seen := map[string]bool{normaliseKeyword(seed.Keyword): true}
+	queue := []ExpandedKeyword{{Keyword: seed.Keyword}}
+	var result []ExpandedKeyword
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current.Depth >= opts.Depth {
+			continue
+		}
+
+		o := seed
+		o.Keyword = current.Keyword
+		o.Params = nil
+		if seed.Params != nil {
+			o.Params = cloneValues(seed.Params)
+			o.Params.Del("q")
+		}
+
+		serps, _, err := kf.JSON(ctx, o)
+		if err != nil {
+			return result, errors.Wrapf(err, "error expanding keyword %q", current.Keyword)
+		}
+
+		for _, text := range serps.RelatedSearches(!opts.ExcludeRefinements) {
+			key := normaliseKeyword(text)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			kw := ExpandedKeyword{Keyword: strings.TrimSpace(text), Depth: current.Depth + 1, Parent: current.Keyword}
+			result = append(result, kw)
+			queue = append(queue, kw)
+			if opts.MaxKeywords > 0 && len(result) >= opts.MaxKeywords {
+				return result, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// normaliseKeyword returns the keyword used for
+// deduplication.
+func normaliseKeyword(keyword string) string {
+	return strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+}
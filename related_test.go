@@ -0,0 +1,138 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"fmt"
+	"github.com/lacuna-seo/luminati/schema"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// relatedFinder is a KeywordFinder that returns related
+// searches from a map keyed by keyword.
+type relatedFinder struct {
+	related map[string][]Related
+	fail    string
+	calls   []Options
+}
+
+func (r *relatedFinder) JSON(_ context.Context, o Options) (Serps, Meta, error) {
+	r.calls = append(r.calls, o)
+	if o.Keyword == r.fail {
+		return Serps{}, Meta{}, fmt.Errorf("lookup error")
+	}
+	return Serps{Related: r.related[o.Keyword]}, Meta{}, nil
+}
+
+func (r *relatedFinder) HTML(_ context.Context, _ Options) (string, Meta, error) {
+	return "", Meta{}, nil
+}
+
+func (t *LuminatiTestSuite) TestRelated() {
+	buf, err := os.ReadFile(filepath.Join("testdata", "response.json"))
+	t.NoError(err)
+
+	r, err := schema.Parse(buf)
+	t.NoError(err)
+
+	got := related(r.Related)
+	t.Len(got, 8)
+	t.Equal("Pizza Hut", got[0].Text)
+	t.True(got[0].Refinement)
+	t.Equal(1, got[0].Rank)
+	t.Equal(28, got[0].GlobalRank)
+
+	r, err = schema.Parse([]byte(`{"related": "wrong"}`))
+	t.NoError(err)
+	t.Nil(related(r.Related))
+}
+
+func (t *LuminatiTestSuite) TestSerps_RelatedSearches() {
+	s := Serps{Related: []Related{
+		{Text: "Pizza Hut", Refinement: true},
+		{Text: "pizza near me"},
+		{Text: ""},
+	}}
+	t.Equal([]string{"pizza near me"}, s.RelatedSearches(false))
+	t.Equal([]string{"Pizza Hut", "pizza near me"}, s.RelatedSearches(true))
+}
+
+func (t *LuminatiTestSuite) TestExpandKeywords() {
+	finder := func() *relatedFinder {
+		return &relatedFinder{related: map[string][]Related{
+			"pizza":         {{Text: "pizza near me"}, {Text: "Pizza Hut", Refinement: true}, {Text: "PIZZA "}},
+			"pizza near me": {{Text: "pizza  Near me"}, {Text: "pizza delivery"}},
+			"Pizza Hut":     {{Text: "pizza hut menu"}},
+		}}
+	}
+
+	tt := map[string]struct {
+		opts ExpandOptions
+		fail string
+		want interface{}
+	}{
+		"Default Depth": {
+			ExpandOptions{},
+			"",
+			[]ExpandedKeyword{
+				{Keyword: "pizza near me", Depth: 1, Parent: "pizza"},
+				{Keyword: "Pizza Hut", Depth: 1, Parent: "pizza"},
+			},
+		},
+		"Depth": {
+			ExpandOptions{Depth: 2},
+			"",
+			[]ExpandedKeyword{
+				{Keyword: "pizza near me", Depth: 1, Parent: "pizza"},
+				{Keyword: "Pizza Hut", Depth: 1, Parent: "pizza"},
+				{Keyword: "pizza delivery", Depth: 2, Parent: "pizza near me"},
+				{Keyword: "pizza hut menu", Depth: 2, Parent: "Pizza Hut"},
+			},
+		},
+		"Exclude Refinements": {
+			ExpandOptions{Depth: 2, ExcludeRefinements: true},
+			"",
+			[]ExpandedKeyword{
+				{Keyword: "pizza near me", Depth: 1, Parent: "pizza"},
+				{Keyword: "pizza delivery", Depth: 2, Parent: "pizza near me"},
+			},
+		},
+		"Max Keywords": {
+			ExpandOptions{Depth: 2, MaxKeywords: 1},
+			"",
+			[]ExpandedKeyword{
+				{Keyword: "pizza near me", Depth: 1, Parent: "pizza"},
+			},
+		},
+		"Error": {
+			ExpandOptions{Depth: 2},
+			"Pizza Hut",
+			`error expanding keyword "Pizza Hut"`,
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			f := finder()
+			f.fail = test.fail
+			seed := Options{Keyword: "pizza", Country: "us", Params: url.Values{"q": {"pizza"}, "hl": {"en"}}}
+			got, err := ExpandKeywords(context.Background(), f, seed, test.opts)
+			if err != nil {
+				t.Contains(err.Error(), test.want)
+				return
+			}
+			t.Equal(test.want, got)
+			for _, call := range f.calls {
+				t.Equal("us", call.Country)
+				t.Equal("en", call.Params.Get("hl"))
+				t.Empty(call.Params.Get("q"))
+			}
+			t.Equal("pizza", seed.Params.Get("q"))
+		})
+	}
+}
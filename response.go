@@ -6,33 +6,15 @@ package luminati
 
 import (
 	"encoding/json"
+	"github.com/lacuna-seo/luminati/schema"
 	"github.com/pkg/errors"
 )
 
-type (
-	// response defines the data received back from the
-	// Luminati API.
-	response struct {
-		Organic []responseOrganic `json:"organic"`
-	}
-	// responseOrganic is the collection of organic items.
-	responseOrganic struct {
-		Rank        int    `json:"rank"`
-		Link        string `json:"link"`
-		DisplayLink string `json:"display_link"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Extensions  []struct {
-			Text   string `json:"text"`
-			Inline bool   `json:"inline"`
-		} `json:"extensions,omitempty"`
-	}
-)
-
-// ToSerps transforms a buffer with options to a collection
-// of serps. Top level features will be found and a query will
-// be built up dependent on the URL passed in options.
-func (r *response) ToSerps(buf []byte) (Serps, error) {
+// toSerps parses a buffer received from the Luminati API
+// into the schema and transforms it to a collection of serps.
+// Top level features will be found and a query will be built
+// up dependent on the URL passed in options.
+func toSerps(buf []byte) (Serps, error) {
 	var excluded = []string{"general", "organic", "pagination", "related"}
 
 	m := map[string]json.RawMessage{}
@@ -41,7 +23,26 @@ func (r *response) ToSerps(buf []byte) (Serps, error) {
 		return Serps{}, errors.Wrap(err, "error unmarshalling luminati response")
 	}
 
-	serps := r.GetSerps()
+	r, err := schema.Parse(buf)
+	if err != nil {
+		return Serps{}, errors.Wrap(err, "error unmarshalling luminati response")
+	}
+
+	// An organic block without a single valid result must
+	// not be mistaken for a keyword without results.
+	if len(r.Organic) == 0 && stringInSlice(FeatureOrganic, r.Invalid) {
+		return Serps{}, errors.New("error unmarshalling luminati response: invalid organic block")
+	}
+
+	serps := getSerps(r.Organic)
+	serps.CodeVersion = r.General.CodeVersion
+	serps.FeaturedSnippets = featuredSnippets(r)
+	serps.TopStories = topStories(r.TopStories)
+	serps.Videos = videos(r.Videos)
+	serps.Social = socialCards(r.Twitter)
+	serps.Images = images(r.Images)
+	serps.Related = related(r.Related)
+	serps.buildLayout(m)
 
 	// Find features before continuing on to get organic
 	// results.
@@ -56,24 +57,59 @@ func (r *response) ToSerps(buf []byte) (Serps, error) {
 	return serps, nil
 }
 
-// GetSerps returns Organic results and Features that
-// appear for the keyword. URLs are cleaned and
-// Organic results are appended.
-func (r *response) GetSerps() Serps {
+// getSerps returns the Organic results that appear for the
+// keyword. URLs are cleaned and Organic results are appended.
+func getSerps(organic []schema.Organic) Serps {
 	s := Serps{
 		//mappedFeatures: make(map[string]string),
 	}
-	for _, v := range r.Organic {
+	for _, v := range organic {
 		link, err := cleanURL(v.Link)
 		if err != nil {
 			continue
 		}
 		serp := Organic{
-			Rank:        v.Rank,
-			Description: v.Description,
-			Link:        link,
+			Rank:         v.Rank,
+			GlobalRank:   v.GlobalRank,
+			Title:        v.Title,
+			Description:  v.Description,
+			Link:         link,
+			OriginalLink: v.Link,
+			Query:        linkQuery(v.Link),
+			Highlight:    textFragment(v.Link),
+			DisplayLink:  v.DisplayLink,
+			Extensions:   extensions(v.Extensions),
+			Image:        v.Image,
+			ImageAlt:     v.ImageAlt,
+			ImageURL:     v.ImageURL,
+			Duration:     v.Duration,
+			DurationSec:  v.DurationSec,
 		}
 		s.Organic = append(s.Organic, serp)
 	}
 	return s
 }
+
+// extensions transforms the response extensions into typed
+// Extensions.
+func extensions(items []schema.Extension) []Extension {
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]Extension, 0, len(items))
+	for _, e := range items {
+		ext := Extension{
+			Kind:   ExtensionKind(e.Type),
+			Text:   e.Text,
+			Link:   e.Link,
+			Rank:   e.Rank,
+			Inline: e.Inline,
+			Key:    e.Key,
+		}
+		for _, v := range e.Value {
+			ext.Values = append(ext.Values, ExtensionValue{Text: v.Text, Link: v.Link})
+		}
+		result = append(result, ext)
+	}
+	return result
+}
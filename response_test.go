@@ -6,22 +6,22 @@ package luminati
 
 import (
 	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
 )
 
-func (t *LuminatiTestSuite) TestResponse_ToSerps() {
+func (t *LuminatiTestSuite) TestToSerps() {
 	tt := map[string]struct {
-		input    interface{}
-		response response
-		want     interface{}
+		input interface{}
+		want  interface{}
 	}{
 		"Marshal Error": {
 			make(chan byte),
-			response{},
 			"unmarshalling luminati response",
 		},
 		"Features": {
 			map[string]interface{}{"images": 1},
-			response{},
 			Serps{
 				Features: []string{"images"},
 				//mappedFeatures: map[string]string{"images": "1"},
@@ -32,27 +32,45 @@ func (t *LuminatiTestSuite) TestResponse_ToSerps() {
 				"images":  1,
 				"general": 1,
 			},
-			response{},
 			Serps{
 				Features: []string{"images"},
 				//mappedFeatures: map[string]string{"images": "1"},
 			},
 		},
 		"Organic": {
-			map[string]interface{}{},
-			response{Organic: []responseOrganic{
-				{Rank: 1, Link: "https://reddico.co.uk", Description: "SEO"},
-			}},
+			map[string]interface{}{
+				"organic": []map[string]interface{}{
+					{"rank": 1, "link": "https://reddico.co.uk", "description": "SEO"},
+				},
+			},
 			Serps{
-				Organic: []Organic{{Rank: 1, Link: "https://reddico.co.uk", Description: "SEO"}},
+				Organic: []Organic{{Rank: 1, Link: "https://reddico.co.uk", OriginalLink: "https://reddico.co.uk", Description: "SEO"}},
 				//mappedFeatures: make(map[string]string),
 			},
 		},
+		"Organic Parameters": {
+			map[string]interface{}{
+				"organic": []map[string]interface{}{
+					{"rank": 1, "link": "https://reddico.co.uk/product?id=1#:~:text=best%20seo", "description": "SEO"},
+				},
+			},
+			Serps{
+				Organic: []Organic{{
+					Rank:         1,
+					Link:         "https://reddico.co.uk/product",
+					OriginalLink: "https://reddico.co.uk/product?id=1#:~:text=best%20seo",
+					Query:        url.Values{"id": []string{"1"}},
+					Highlight:    "best seo",
+					Description:  "SEO",
+				}},
+			},
+		},
 		"Organic Bad URL": {
-			map[string]interface{}{},
-			response{Organic: []responseOrganic{
-				{Rank: 1, Link: "postgres://user:abc{", Description: "SEO"},
-			}},
+			map[string]interface{}{
+				"organic": []map[string]interface{}{
+					{"rank": 1, "link": "postgres://user:abc{", "description": "SEO"},
+				},
+			},
 			Serps{
 				//mappedFeatures: make(map[string]string),
 			},
@@ -60,12 +78,12 @@ func (t *LuminatiTestSuite) TestResponse_ToSerps() {
 		"Organic With Features": {
 			map[string]interface{}{
 				"images": 1,
+				"organic": []map[string]interface{}{
+					{"rank": 1, "link": "https://reddico.co.uk", "description": "SEO"},
+				},
 			},
-			response{Organic: []responseOrganic{
-				{Rank: 1, Link: "https://reddico.co.uk", Description: "SEO"},
-			}},
 			Serps{
-				Organic:  []Organic{{Rank: 1, Link: "https://reddico.co.uk", Description: "SEO"}},
+				Organic:  []Organic{{Rank: 1, Link: "https://reddico.co.uk", OriginalLink: "https://reddico.co.uk", Description: "SEO"}},
 				Features: []string{"images"},
 				//mappedFeatures: map[string]string{"images": "1"},
 			},
@@ -75,7 +93,7 @@ func (t *LuminatiTestSuite) TestResponse_ToSerps() {
 	for name, test := range tt {
 		t.Run(name, func() {
 			buf, _ := json.Marshal(test.input) // Ignore on purpose
-			got, err := test.response.ToSerps(buf)
+			got, err := toSerps(buf)
 			if err != nil {
 				t.Contains(err.Error(), test.want)
 				return
@@ -84,3 +102,48 @@ func (t *LuminatiTestSuite) TestResponse_ToSerps() {
 		})
 	}
 }
+
+func (t *LuminatiTestSuite) TestToSerps_Fidelity() {
+	buf, err := os.ReadFile(filepath.Join("testdata", "response.json"))
+	t.NoError(err)
+
+	got, err := toSerps(buf)
+	t.NoError(err)
+	t.Equal("1.514", got.CodeVersion)
+	t.Len(got.Organic, 10)
+
+	first := got.Organic[0]
+	t.Equal(1, first.Rank)
+	t.Equal(8, first.GlobalRank)
+	t.Equal("Pizza Hut: Pizza Delivery | Pizza Carryout | Coupons | Wings ...", first.Title)
+	t.Equal("https://www.pizzahut.com", first.DisplayLink)
+	t.Len(first.SiteLinks(), 4)
+	t.Equal(Extension{Kind: ExtensionSiteLink, Text: "Contact Us", Link: "https://www.pizzahut.com/link.php?contactus", Rank: 1}, first.SiteLinks()[0])
+
+	var facts []Extension
+	for _, o := range got.Organic {
+		facts = append(facts, o.ExtensionsOf(ExtensionFact)...)
+	}
+	t.Len(facts, 4)
+	t.Equal("Region or state", facts[2].Key)
+	t.Equal([]ExtensionValue{
+		{Text: "Campania", Link: "http://en.wikipedia.org/wiki/Campania"},
+		{Text: "Naples", Link: "http://en.wikipedia.org/wiki/Naples"},
+	}, facts[2].Values)
+}
+
+func (t *LuminatiTestSuite) TestToSerps_InvalidOrganic() {
+	got, err := toSerps([]byte(`{"organic": [{"link": "https://a.com", "rank": 1}, {"link": "https://b.com", "rank": "2"}, 1]}`))
+	t.NoError(err)
+	t.Len(got.Organic, 2)
+	t.Equal("https://a.com", got.Organic[0].Link)
+	t.Equal(1, got.Organic[0].Rank)
+	t.Equal("https://b.com", got.Organic[1].Link)
+	t.Equal(0, got.Organic[1].Rank)
+
+	_, err = toSerps([]byte(`{"organic": "wrong"}`))
+	t.EqualError(err, "error unmarshalling luminati response: invalid organic block")
+
+	_, err = toSerps([]byte(`{"organic": [1]}`))
+	t.Error(err)
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"github.com/lacuna-seo/luminati"
+	"github.com/lacuna-seo/luminati/rpc/luminatipb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"io"
+)
+
+type (
+	// Client implements luminati.KeywordFinder by calling a
+	// remote Server.
+	Client struct {
+		client luminatipb.KeywordFinderClient
+	}
+	// BatchResult is the result of the options at Index in a
+	// Batch call.
+	BatchResult struct {
+		Index int
+		Serps luminati.Serps
+		Meta  luminati.Meta
+		Err   error
+	}
+)
+
+var _ luminati.KeywordFinder = (*Client)(nil)
+
+// NewClient creates a Client using the connection, such as
+// one returned by grpc.NewClient.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{client: luminatipb.NewKeywordFinderClient(cc)}
+}
+
+// JSON implements luminati.KeywordFinder.
+func (c *Client) JSON(ctx context.Context, o luminati.Options) (luminati.Serps, luminati.Meta, error) {
+	resp, err := c.client.JSON(ctx, &luminatipb.JSONRequest{Options: toOptions(o)})
+	if err != nil {
+		return luminati.Serps{}, luminati.Meta{}, fromStatus(err)
+	}
+	return fromSerps(resp.GetSerps()), fromMeta(resp.GetMeta()), nil
+}
+
+// HTML implements luminati.KeywordFinder.
+func (c *Client) HTML(ctx context.Context, o luminati.Options) (string, luminati.Meta, error) {
+	resp, err := c.client.HTML(ctx, &luminatipb.HTMLRequest{Options: toOptions(o)})
+	if err != nil {
+		return "", luminati.Meta{}, fromStatus(err)
+	}
+	return resp.GetHtml(), fromMeta(resp.GetMeta()), nil
+}
+
+// CheckURL returns the rank of the URL in the results for
+// the options, see luminati.Serps.CheckURL.
+func (c *Client) CheckURL(ctx context.Context, o luminati.Options, url string) (luminati.Domain, luminati.Meta, error) {
+	resp, err := c.client.CheckURL(ctx, &luminatipb.CheckURLRequest{Options: toOptions(o), Url: url})
+	if err != nil {
+		return luminati.Domain{}, luminati.Meta{}, fromStatus(err)
+	}
+	return fromDomain(resp.GetDomain()), fromMeta(resp.GetMeta()), nil
+}
+
+// Batch looks up the options with the given concurrency,
+// zero for the server default, calling fn with each result
+// as it is streamed back. Results arrive in the order they
+// complete. An error is returned if the stream fails, failed
+// lookups are passed to fn with Err set.
+func (c *Client) Batch(ctx context.Context, opts []luminati.Options, concurrency int, fn func(r BatchResult)) error {
+	req := &luminatipb.BatchRequest{Concurrency: uint32(concurrency)}
+	for _, o := range opts {
+		req.Options = append(req.Options, toOptions(o))
+	}
+
+	stream, err := c.client.Batch(ctx, req)
+	if err != nil {
+		return fromStatus(err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fromStatus(err)
+		}
+		r := BatchResult{Index: int(resp.GetIndex())}
+		if resp.GetError() != "" {
+			r.Err = errors.New(resp.GetError())
+		} else {
+			r.Serps = fromSerps(resp.GetSerps())
+			r.Meta = fromMeta(resp.GetMeta())
+		}
+		fn(r)
+	}
+}
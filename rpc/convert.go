@@ -0,0 +1,516 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"errors"
+	"github.com/lacuna-seo/luminati"
+	"github.com/lacuna-seo/luminati/rpc/luminatipb"
+	"github.com/lacuna-seo/luminati/schema"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/url"
+)
+
+// toOptions converts luminati.Options to protobuf.
+func toOptions(o luminati.Options) *luminatipb.Options {
+	return &luminatipb.Options{
+		Keyword: o.Keyword,
+		Country: o.Country,
+		Params:  toValues(o.Params),
+		Desktop: o.Desktop,
+	}
+}
+
+// fromOptions converts protobuf Options to luminati.Options.
+func fromOptions(o *luminatipb.Options) luminati.Options {
+	return luminati.Options{
+		Keyword: o.GetKeyword(),
+		Country: o.GetCountry(),
+		Params:  fromValues(o.GetParams()),
+		Desktop: o.GetDesktop(),
+	}
+}
+
+// toValues converts url.Values to protobuf, nil if empty.
+func toValues(v url.Values) map[string]*luminatipb.Values {
+	if len(v) == 0 {
+		return nil
+	}
+	m := make(map[string]*luminatipb.Values, len(v))
+	for key, values := range v {
+		m[key] = &luminatipb.Values{Values: values}
+	}
+	return m
+}
+
+// fromValues converts protobuf Values to url.Values, nil if
+// empty.
+func fromValues(m map[string]*luminatipb.Values) url.Values {
+	if len(m) == 0 {
+		return nil
+	}
+	v := make(url.Values, len(m))
+	for key, values := range m {
+		v[key] = values.GetValues()
+	}
+	return v
+}
+
+// toMeta converts luminati.Meta to protobuf.
+func toMeta(m luminati.Meta) *luminatipb.Meta {
+	pb := &luminatipb.Meta{
+		CacheKey:    m.CacheKey,
+		RequestUrl:  m.RequestURL,
+		LatencyTime: durationpb.New(m.LatencyTime),
+		WasCached:   m.WasCached,
+		Body:        m.Body,
+		Stale:       m.Stale,
+	}
+	if !m.RequestTime.IsZero() {
+		pb.RequestTime = timestamppb.New(m.RequestTime)
+	}
+	if !m.ResponseTime.IsZero() {
+		pb.ResponseTime = timestamppb.New(m.ResponseTime)
+	}
+	for _, d := range m.Drift {
+		pb.Drift = append(pb.Drift, &luminatipb.Drift{
+			Kind:        string(d.Kind),
+			Path:        d.Path,
+			Expected:    d.Expected,
+			Got:         d.Got,
+			CodeVersion: d.CodeVersion,
+		})
+	}
+	for _, e := range m.CacheErrors {
+		if e == nil {
+			continue
+		}
+		ce := &luminatipb.CacheError{Op: e.Op, Key: e.Key}
+		if e.Err != nil {
+			ce.Error = e.Err.Error()
+		}
+		pb.CacheErrors = append(pb.CacheErrors, ce)
+	}
+	return pb
+}
+
+// fromMeta converts protobuf Meta to luminati.Meta.
+func fromMeta(pb *luminatipb.Meta) luminati.Meta {
+	m := luminati.Meta{
+		CacheKey:    pb.GetCacheKey(),
+		RequestURL:  pb.GetRequestUrl(),
+		LatencyTime: pb.GetLatencyTime().AsDuration(),
+		WasCached:   pb.GetWasCached(),
+		Body:        pb.GetBody(),
+		Stale:       pb.GetStale(),
+	}
+	if pb.GetRequestTime() != nil {
+		m.RequestTime = pb.GetRequestTime().AsTime()
+	}
+	if pb.GetResponseTime() != nil {
+		m.ResponseTime = pb.GetResponseTime().AsTime()
+	}
+	for _, d := range pb.GetDrift() {
+		m.Drift = append(m.Drift, schema.Drift{
+			Kind:        schema.DriftKind(d.GetKind()),
+			Path:        d.GetPath(),
+			Expected:    d.GetExpected(),
+			Got:         d.GetGot(),
+			CodeVersion: d.GetCodeVersion(),
+		})
+	}
+	for _, e := range pb.GetCacheErrors() {
+		var err error
+		switch e.GetError() {
+		case "":
+		case luminati.ErrCacheOpen.Error():
+			err = luminati.ErrCacheOpen
+		default:
+			err = errors.New(e.GetError())
+		}
+		m.CacheErrors = append(m.CacheErrors, &luminati.CacheError{
+			Op:  e.GetOp(),
+			Key: e.GetKey(),
+			Err: err,
+		})
+	}
+	return m
+}
+
+// toSerps converts luminati.Serps to protobuf.
+func toSerps(s luminati.Serps) *luminatipb.Serps {
+	pb := &luminatipb.Serps{
+		Organic:     toOrganic(s.Organic),
+		Features:    s.Features,
+		TopStories:  toTopStories(s.TopStories),
+		Videos:      toVideos(s.Videos),
+		Social:      toSocial(s.Social),
+		Images:      toImages(s.Images),
+		CodeVersion: s.CodeVersion,
+	}
+	for _, f := range s.FeaturedSnippets {
+		pb.FeaturedSnippets = append(pb.FeaturedSnippets, toSnippet(f))
+	}
+	for _, r := range s.Related {
+		pb.Related = append(pb.Related, &luminatipb.Related{
+			Text:       r.Text,
+			Link:       r.Link,
+			Refinement: r.Refinement,
+			Expanded:   r.Expanded,
+			Image:      r.Image,
+			ImageAlt:   r.ImageAlt,
+			ImageUrl:   r.ImageURL,
+			Rank:       int32(r.Rank),
+			GlobalRank: int32(r.GlobalRank),
+		})
+	}
+	for _, b := range s.Layout {
+		pb.Layout = append(pb.Layout, &luminatipb.LayoutBlock{
+			Feature:    b.Feature,
+			Position:   int32(b.Position),
+			GlobalRank: int32(b.GlobalRank),
+			Items:      int32(b.Items),
+			Rank:       int32(b.Rank),
+			Link:       b.Link,
+		})
+	}
+	return pb
+}
+
+// fromSerps converts protobuf Serps to luminati.Serps.
+func fromSerps(pb *luminatipb.Serps) luminati.Serps {
+	s := luminati.Serps{
+		Organic:     fromOrganic(pb.GetOrganic()),
+		Features:    pb.GetFeatures(),
+		TopStories:  fromTopStories(pb.GetTopStories()),
+		Videos:      fromVideos(pb.GetVideos()),
+		Social:      fromSocial(pb.GetSocial()),
+		Images:      fromImages(pb.GetImages()),
+		CodeVersion: pb.GetCodeVersion(),
+	}
+	for _, f := range pb.GetFeaturedSnippets() {
+		s.FeaturedSnippets = append(s.FeaturedSnippets, *fromSnippet(f))
+	}
+	for _, r := range pb.GetRelated() {
+		s.Related = append(s.Related, luminati.Related{
+			Text:       r.GetText(),
+			Link:       r.GetLink(),
+			Refinement: r.GetRefinement(),
+			Expanded:   r.GetExpanded(),
+			Image:      r.GetImage(),
+			ImageAlt:   r.GetImageAlt(),
+			ImageURL:   r.GetImageUrl(),
+			Rank:       int(r.GetRank()),
+			GlobalRank: int(r.GetGlobalRank()),
+		})
+	}
+	for _, b := range pb.GetLayout() {
+		s.Layout = append(s.Layout, luminati.LayoutBlock{
+			Feature:    b.GetFeature(),
+			Position:   int(b.GetPosition()),
+			GlobalRank: int(b.GetGlobalRank()),
+			Items:      int(b.GetItems()),
+			Rank:       int(b.GetRank()),
+			Link:       b.GetLink(),
+		})
+	}
+	return s
+}
+
+// toDomain converts luminati.Domain to protobuf.
+func toDomain(d luminati.Domain) *luminatipb.Domain {
+	pb := &luminatipb.Domain{
+		Query: &luminatipb.Query{
+			Rank:        int32(d.Query.Rank),
+			Link:        d.Query.Link,
+			Description: d.Query.Description,
+			Features:    d.Query.Features,
+		},
+		Results:    toOrganic(d.Results),
+		TopStories: toTopStories(d.TopStories),
+		Videos:     toVideos(d.Videos),
+		Social:     toSocial(d.Social),
+		Images:     toImages(d.Images),
+	}
+	if d.FeaturedSnippet != nil {
+		pb.FeaturedSnippet = toSnippet(*d.FeaturedSnippet)
+	}
+	return pb
+}
+
+// fromDomain converts protobuf Domain to luminati.Domain.
+func fromDomain(pb *luminatipb.Domain) luminati.Domain {
+	return luminati.Domain{
+		Query: luminati.Query{
+			Rank:        int(pb.GetQuery().GetRank()),
+			Link:        pb.GetQuery().GetLink(),
+			Description: pb.GetQuery().GetDescription(),
+			Features:    pb.GetQuery().GetFeatures(),
+		},
+		Results:         fromOrganic(pb.GetResults()),
+		FeaturedSnippet: fromSnippet(pb.GetFeaturedSnippet()),
+		TopStories:      fromTopStories(pb.GetTopStories()),
+		Videos:          fromVideos(pb.GetVideos()),
+		Social:          fromSocial(pb.GetSocial()),
+		Images:          fromImages(pb.GetImages()),
+	}
+}
+
+// toOrganic converts organic results to protobuf.
+func toOrganic(organic []luminati.Organic) []*luminatipb.Organic {
+	var pb []*luminatipb.Organic
+	for _, o := range organic {
+		result := &luminatipb.Organic{
+			Rank:         int32(o.Rank),
+			GlobalRank:   int32(o.GlobalRank),
+			AbsoluteRank: int32(o.AbsoluteRank),
+			Title:        o.Title,
+			Description:  o.Description,
+			Link:         o.Link,
+			OriginalLink: o.OriginalLink,
+			Query:        toValues(o.Query),
+			Highlight:    o.Highlight,
+			DisplayLink:  o.DisplayLink,
+			Image:        o.Image,
+			ImageAlt:     o.ImageAlt,
+			ImageUrl:     o.ImageURL,
+			Duration:     o.Duration,
+			DurationSec:  int32(o.DurationSec),
+		}
+		for _, e := range o.Extensions {
+			ext := &luminatipb.Extension{
+				Kind:   string(e.Kind),
+				Text:   e.Text,
+				Link:   e.Link,
+				Rank:   int32(e.Rank),
+				Inline: e.Inline,
+				Key:    e.Key,
+			}
+			for _, v := range e.Values {
+				ext.Values = append(ext.Values, &luminatipb.ExtensionValue{Text: v.Text, Link: v.Link})
+			}
+			result.Extensions = append(result.Extensions, ext)
+		}
+		pb = append(pb, result)
+	}
+	return pb
+}
+
+// fromOrganic converts protobuf organic results.
+func fromOrganic(pb []*luminatipb.Organic) []luminati.Organic {
+	var organic []luminati.Organic
+	for _, o := range pb {
+		result := luminati.Organic{
+			Rank:         int(o.GetRank()),
+			GlobalRank:   int(o.GetGlobalRank()),
+			AbsoluteRank: int(o.GetAbsoluteRank()),
+			Title:        o.GetTitle(),
+			Description:  o.GetDescription(),
+			Link:         o.GetLink(),
+			OriginalLink: o.GetOriginalLink(),
+			Query:        fromValues(o.GetQuery()),
+			Highlight:    o.GetHighlight(),
+			DisplayLink:  o.GetDisplayLink(),
+			Image:        o.GetImage(),
+			ImageAlt:     o.GetImageAlt(),
+			ImageURL:     o.GetImageUrl(),
+			Duration:     o.GetDuration(),
+			DurationSec:  int(o.GetDurationSec()),
+		}
+		for _, e := range o.GetExtensions() {
+			ext := luminati.Extension{
+				Kind:   luminati.ExtensionKind(e.GetKind()),
+				Text:   e.GetText(),
+				Link:   e.GetLink(),
+				Rank:   int(e.GetRank()),
+				Inline: e.GetInline(),
+				Key:    e.GetKey(),
+			}
+			for _, v := range e.GetValues() {
+				ext.Values = append(ext.Values, luminati.ExtensionValue{Text: v.GetText(), Link: v.GetLink()})
+			}
+			result.Extensions = append(result.Extensions, ext)
+		}
+		organic = append(organic, result)
+	}
+	return organic
+}
+
+// toSnippet converts a featured snippet to protobuf.
+func toSnippet(f luminati.FeaturedSnippet) *luminatipb.FeaturedSnippet {
+	pb := &luminatipb.FeaturedSnippet{
+		Kind:        string(f.Kind),
+		Title:       f.Title,
+		Text:        f.Text,
+		Items:       f.Items,
+		Link:        f.Link,
+		DisplayLink: f.DisplayLink,
+		Rank:        int32(f.Rank),
+		GlobalRank:  int32(f.GlobalRank),
+		Feature:     f.Feature,
+	}
+	for _, row := range f.Table {
+		pb.Table = append(pb.Table, &luminatipb.TableRow{Cells: row})
+	}
+	return pb
+}
+
+// fromSnippet converts a protobuf featured snippet, nil if
+// there is none.
+func fromSnippet(pb *luminatipb.FeaturedSnippet) *luminati.FeaturedSnippet {
+	if pb == nil {
+		return nil
+	}
+	f := &luminati.FeaturedSnippet{
+		Kind:        luminati.SnippetKind(pb.GetKind()),
+		Title:       pb.GetTitle(),
+		Text:        pb.GetText(),
+		Items:       pb.GetItems(),
+		Link:        pb.GetLink(),
+		DisplayLink: pb.GetDisplayLink(),
+		Rank:        int(pb.GetRank()),
+		GlobalRank:  int(pb.GetGlobalRank()),
+		Feature:     pb.GetFeature(),
+	}
+	for _, row := range pb.GetTable() {
+		f.Table = append(f.Table, row.GetCells())
+	}
+	return f
+}
+
+// toTopStories converts top stories to protobuf.
+func toTopStories(stories []luminati.TopStory) []*luminatipb.TopStory {
+	var pb []*luminatipb.TopStory
+	for _, s := range stories {
+		pb = append(pb, &luminatipb.TopStory{
+			Title:      s.Title,
+			Source:     s.Source,
+			Age:        s.Age,
+			Link:       s.Link,
+			Rank:       int32(s.Rank),
+			GlobalRank: int32(s.GlobalRank),
+		})
+	}
+	return pb
+}
+
+// fromTopStories converts protobuf top stories.
+func fromTopStories(pb []*luminatipb.TopStory) []luminati.TopStory {
+	var stories []luminati.TopStory
+	for _, s := range pb {
+		stories = append(stories, luminati.TopStory{
+			Title:      s.GetTitle(),
+			Source:     s.GetSource(),
+			Age:        s.GetAge(),
+			Link:       s.GetLink(),
+			Rank:       int(s.GetRank()),
+			GlobalRank: int(s.GetGlobalRank()),
+		})
+	}
+	return stories
+}
+
+// toVideos converts videos to protobuf.
+func toVideos(videos []luminati.Video) []*luminatipb.Video {
+	var pb []*luminatipb.Video
+	for _, v := range videos {
+		pb = append(pb, &luminatipb.Video{
+			Title:       v.Title,
+			Platform:    v.Platform,
+			Duration:    v.Duration,
+			DurationSec: int32(v.DurationSec),
+			Link:        v.Link,
+			Rank:        int32(v.Rank),
+			GlobalRank:  int32(v.GlobalRank),
+		})
+	}
+	return pb
+}
+
+// fromVideos converts protobuf videos.
+func fromVideos(pb []*luminatipb.Video) []luminati.Video {
+	var videos []luminati.Video
+	for _, v := range pb {
+		videos = append(videos, luminati.Video{
+			Title:       v.GetTitle(),
+			Platform:    v.GetPlatform(),
+			Duration:    v.GetDuration(),
+			DurationSec: int(v.GetDurationSec()),
+			Link:        v.GetLink(),
+			Rank:        int(v.GetRank()),
+			GlobalRank:  int(v.GetGlobalRank()),
+		})
+	}
+	return videos
+}
+
+// toSocial converts social cards to protobuf.
+func toSocial(cards []luminati.SocialCard) []*luminatipb.SocialCard {
+	var pb []*luminatipb.SocialCard
+	for _, c := range cards {
+		pb = append(pb, &luminatipb.SocialCard{
+			Platform:   c.Platform,
+			Account:    c.Account,
+			Title:      c.Title,
+			Link:       c.Link,
+			Rank:       int32(c.Rank),
+			GlobalRank: int32(c.GlobalRank),
+		})
+	}
+	return pb
+}
+
+// fromSocial converts protobuf social cards.
+func fromSocial(pb []*luminatipb.SocialCard) []luminati.SocialCard {
+	var cards []luminati.SocialCard
+	for _, c := range pb {
+		cards = append(cards, luminati.SocialCard{
+			Platform:   c.GetPlatform(),
+			Account:    c.GetAccount(),
+			Title:      c.GetTitle(),
+			Link:       c.GetLink(),
+			Rank:       int(c.GetRank()),
+			GlobalRank: int(c.GetGlobalRank()),
+		})
+	}
+	return cards
+}
+
+// toImages converts images to protobuf.
+func toImages(images []luminati.Image) []*luminatipb.Image {
+	var pb []*luminatipb.Image
+	for _, i := range images {
+		pb = append(pb, &luminatipb.Image{
+			Image:      i.Image,
+			ImageAlt:   i.ImageAlt,
+			ImageUrl:   i.ImageURL,
+			Tag:        i.Tag,
+			Link:       i.Link,
+			Source:     i.Source,
+			Rank:       int32(i.Rank),
+			GlobalRank: int32(i.GlobalRank),
+		})
+	}
+	return pb
+}
+
+// fromImages converts protobuf images.
+func fromImages(pb []*luminatipb.Image) []luminati.Image {
+	var images []luminati.Image
+	for _, i := range pb {
+		images = append(images, luminati.Image{
+			Image:      i.GetImage(),
+			ImageAlt:   i.GetImageAlt(),
+			ImageURL:   i.GetImageUrl(),
+			Tag:        i.GetTag(),
+			Link:       i.GetLink(),
+			Source:     i.GetSource(),
+			Rank:       int(i.GetRank()),
+			GlobalRank: int(i.GetGlobalRank()),
+		})
+	}
+	return images
+}
@@ -0,0 +1,263 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: luminati.proto
+
+package luminatipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	KeywordFinder_JSON_FullMethodName     = "/luminati.v1.KeywordFinder/JSON"
+	KeywordFinder_HTML_FullMethodName     = "/luminati.v1.KeywordFinder/HTML"
+	KeywordFinder_CheckURL_FullMethodName = "/luminati.v1.KeywordFinder/CheckURL"
+	KeywordFinder_Batch_FullMethodName    = "/luminati.v1.KeywordFinder/Batch"
+)
+
+// KeywordFinderClient is the client API for KeywordFinder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// KeywordFinder obtains SERP data from the BrightData
+// (Luminati) API, mirroring luminati.KeywordFinder.
+type KeywordFinderClient interface {
+	// JSON returns the processed results for the options.
+	JSON(ctx context.Context, in *JSONRequest, opts ...grpc.CallOption) (*JSONResponse, error)
+	// HTML returns the raw HTML of the results page for the
+	// options.
+	HTML(ctx context.Context, in *HTMLRequest, opts ...grpc.CallOption) (*HTMLResponse, error)
+	// CheckURL returns the rank of a URL in the results for the
+	// options, see luminati.Serps.CheckURL.
+	CheckURL(ctx context.Context, in *CheckURLRequest, opts ...grpc.CallOption) (*CheckURLResponse, error)
+	// Batch looks up the results for many options, streaming
+	// each as it completes.
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchResponse], error)
+}
+
+type keywordFinderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeywordFinderClient(cc grpc.ClientConnInterface) KeywordFinderClient {
+	return &keywordFinderClient{cc}
+}
+
+func (c *keywordFinderClient) JSON(ctx context.Context, in *JSONRequest, opts ...grpc.CallOption) (*JSONResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JSONResponse)
+	err := c.cc.Invoke(ctx, KeywordFinder_JSON_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keywordFinderClient) HTML(ctx context.Context, in *HTMLRequest, opts ...grpc.CallOption) (*HTMLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HTMLResponse)
+	err := c.cc.Invoke(ctx, KeywordFinder_HTML_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keywordFinderClient) CheckURL(ctx context.Context, in *CheckURLRequest, opts ...grpc.CallOption) (*CheckURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckURLResponse)
+	err := c.cc.Invoke(ctx, KeywordFinder_CheckURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keywordFinderClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KeywordFinder_ServiceDesc.Streams[0], KeywordFinder_Batch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchRequest, BatchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KeywordFinder_BatchClient = grpc.ServerStreamingClient[BatchResponse]
+
+// KeywordFinderServer is the server API for KeywordFinder service.
+// All implementations must embed UnimplementedKeywordFinderServer
+// for forward compatibility.
+//
+// KeywordFinder obtains SERP data from the BrightData
+// (Luminati) API, mirroring luminati.KeywordFinder.
+type KeywordFinderServer interface {
+	// JSON returns the processed results for the options.
+	JSON(context.Context, *JSONRequest) (*JSONResponse, error)
+	// HTML returns the raw HTML of the results page for the
+	// options.
+	HTML(context.Context, *HTMLRequest) (*HTMLResponse, error)
+	// CheckURL returns the rank of a URL in the results for the
+	// options, see luminati.Serps.CheckURL.
+	CheckURL(context.Context, *CheckURLRequest) (*CheckURLResponse, error)
+	// Batch looks up the results for many options, streaming
+	// each as it completes.
+	Batch(*BatchRequest, grpc.ServerStreamingServer[BatchResponse]) error
+	mustEmbedUnimplementedKeywordFinderServer()
+}
+
+// UnimplementedKeywordFinderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKeywordFinderServer struct{}
+
+func (UnimplementedKeywordFinderServer) JSON(context.Context, *JSONRequest) (*JSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSON not implemented")
+}
+func (UnimplementedKeywordFinderServer) HTML(context.Context, *HTMLRequest) (*HTMLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HTML not implemented")
+}
+func (UnimplementedKeywordFinderServer) CheckURL(context.Context, *CheckURLRequest) (*CheckURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckURL not implemented")
+}
+func (UnimplementedKeywordFinderServer) Batch(*BatchRequest, grpc.ServerStreamingServer[BatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedKeywordFinderServer) mustEmbedUnimplementedKeywordFinderServer() {}
+func (UnimplementedKeywordFinderServer) testEmbeddedByValue()                       {}
+
+// UnsafeKeywordFinderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeywordFinderServer will
+// result in compilation errors.
+type UnsafeKeywordFinderServer interface {
+	mustEmbedUnimplementedKeywordFinderServer()
+}
+
+func RegisterKeywordFinderServer(s grpc.ServiceRegistrar, srv KeywordFinderServer) {
+	// If the following call pancis, it indicates UnimplementedKeywordFinderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KeywordFinder_ServiceDesc, srv)
+}
+
+func _KeywordFinder_JSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeywordFinderServer).JSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeywordFinder_JSON_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeywordFinderServer).JSON(ctx, req.(*JSONRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeywordFinder_HTML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HTMLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeywordFinderServer).HTML(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeywordFinder_HTML_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeywordFinderServer).HTML(ctx, req.(*HTMLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeywordFinder_CheckURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeywordFinderServer).CheckURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeywordFinder_CheckURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeywordFinderServer).CheckURL(ctx, req.(*CheckURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeywordFinder_Batch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeywordFinderServer).Batch(m, &grpc.GenericServerStream[BatchRequest, BatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KeywordFinder_BatchServer = grpc.ServerStreamingServer[BatchResponse]
+
+// KeywordFinder_ServiceDesc is the grpc.ServiceDesc for KeywordFinder service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KeywordFinder_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "luminati.v1.KeywordFinder",
+	HandlerType: (*KeywordFinderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JSON",
+			Handler:    _KeywordFinder_JSON_Handler,
+		},
+		{
+			MethodName: "HTML",
+			Handler:    _KeywordFinder_HTML_Handler,
+		},
+		{
+			MethodName: "CheckURL",
+			Handler:    _KeywordFinder_CheckURL_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Batch",
+			Handler:       _KeywordFinder_Batch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "luminati.proto",
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rpc serves a luminati.KeywordFinder over gRPC and
+// provides a client that implements luminati.KeywordFinder,
+// so services can swap between in-process and remote
+// lookups. The protobuf definitions are in luminati.proto
+// and the generated code in luminatipb.
+package rpc
+
+import (
+	"context"
+	"github.com/lacuna-seo/luminati"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// toStatus converts an error returned by a KeywordFinder to
+// a gRPC status error.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch {
+	case err == luminati.ErrNoKeywordProvided:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case err == luminati.ErrClientTimeout || errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, luminati.ErrBreakerOpen):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// fromStatus converts a gRPC status error to the error the
+// KeywordFinder would have returned in process, where it is
+// one of the exported luminati errors.
+func fromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch {
+	case s.Code() == codes.InvalidArgument && s.Message() == luminati.ErrNoKeywordProvided.Error():
+		return luminati.ErrNoKeywordProvided
+	case s.Code() == codes.DeadlineExceeded:
+		return luminati.ErrClientTimeout
+	case s.Code() == codes.Canceled:
+		return context.Canceled
+	case s.Code() == codes.Unavailable && strings.HasPrefix(s.Message(), luminati.ErrBreakerOpen.Error()):
+		return luminati.ErrBreakerOpen
+	}
+	return errors.New(s.Message())
+}
@@ -0,0 +1,295 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/lacuna-seo/luminati"
+	"github.com/lacuna-seo/luminati/schema"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// RPCTestSuite defines the helper used for gRPC testing.
+type RPCTestSuite struct {
+	suite.Suite
+}
+
+// TestRPC asserts testing has begun.
+func TestRPC(t *testing.T) {
+	suite.Run(t, new(RPCTestSuite))
+}
+
+// finder is a luminati.KeywordFinder returning fixed results,
+// or err for the keyword "error".
+type finder struct {
+	serps luminati.Serps
+	meta  luminati.Meta
+	html  string
+	err   error
+	mtx   sync.Mutex
+	calls []luminati.Options
+}
+
+func (f *finder) JSON(_ context.Context, o luminati.Options) (luminati.Serps, luminati.Meta, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.calls = append(f.calls, o)
+	if f.err != nil && o.Keyword == "error" {
+		return luminati.Serps{}, luminati.Meta{}, f.err
+	}
+	return f.serps, f.meta, nil
+}
+
+func (f *finder) HTML(_ context.Context, o luminati.Options) (string, luminati.Meta, error) {
+	if f.err != nil && o.Keyword == "error" {
+		return "", luminati.Meta{}, f.err
+	}
+	return f.html, f.meta, nil
+}
+
+var (
+	// testSerps has every field of luminati.Serps set.
+	testSerps = luminati.Serps{
+		Organic: []luminati.Organic{
+			{
+				Rank: 1, GlobalRank: 8, AbsoluteRank: 3, Title: "MacBook Air", Description: "Apple",
+				Link: "https://www.apple.com/macbook-air/", OriginalLink: "https://www.apple.com/macbook-air/?src=g",
+				Query: url.Values{"src": {"g"}}, Highlight: "MacBook", DisplayLink: "apple.com",
+				Extensions: []luminati.Extension{
+					{Kind: luminati.ExtensionSiteLink, Text: "Buy", Link: "https://www.apple.com/shop", Rank: 1, Inline: true},
+					{Kind: luminati.ExtensionFact, Key: "Chip", Values: []luminati.ExtensionValue{{Text: "M3", Link: "https://www.apple.com/m3"}}},
+				},
+				Image: "data:image", ImageAlt: "MacBook", ImageURL: "https://www.apple.com/air.png", Duration: "1:00", DurationSec: 60,
+			},
+			{Rank: 2, Link: "https://www.currys.co.uk/macbook", Description: "Currys"},
+		},
+		Features: []string{"images", "top_stories"},
+		FeaturedSnippets: []luminati.FeaturedSnippet{
+			{Kind: luminati.SnippetTable, Title: "Sizes", Table: [][]string{{"13", "15"}}, Items: []string{"a"}, Link: "https://www.apple.com/macbook-air/", DisplayLink: "apple.com", Rank: 1, GlobalRank: 1, Feature: "featured_snippets"},
+		},
+		TopStories:  []luminati.TopStory{{Title: "New Air", Source: "BBC", Age: "1 day ago", Link: "https://www.bbc.co.uk/air", Rank: 1, GlobalRank: 2}},
+		Videos:      []luminati.Video{{Title: "Review", Platform: "YouTube", Duration: "10:00", DurationSec: 600, Link: "https://www.youtube.com/watch", Rank: 1, GlobalRank: 3}},
+		Social:      []luminati.SocialCard{{Platform: "Twitter", Account: "@apple", Title: "Apple", Link: "https://twitter.com/apple", Rank: 1, GlobalRank: 4}},
+		Images:      []luminati.Image{{Image: "data:image", ImageAlt: "Air", ImageURL: "https://www.apple.com/air.png", Tag: "Silver", Link: "https://www.apple.com/macbook-air/", Source: "Apple", Rank: 1, GlobalRank: 5}},
+		Related:     []luminati.Related{{Text: "macbook pro", Link: "https://www.google.com/search?q=macbook+pro", Refinement: true, Expanded: true, Image: "data:image", ImageAlt: "Pro", ImageURL: "https://www.apple.com/pro.png", Rank: 1, GlobalRank: 6}},
+		Layout:      []luminati.LayoutBlock{{Feature: "organic", Position: 3, GlobalRank: 8, Items: 1, Rank: 1, Link: "https://www.apple.com/macbook-air/"}},
+		CodeVersion: "1.514",
+	}
+	// testMeta has every field of luminati.Meta set.
+	testMeta = luminati.Meta{
+		CacheKey:     "luminati-client-macbook-uk-mobile-json",
+		RequestURL:   "http://www.google.com/search?q=macbook",
+		RequestTime:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		ResponseTime: time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC),
+		LatencyTime:  time.Second,
+		WasCached:    true,
+		Body:         "{}",
+		Drift:        []schema.Drift{{Kind: schema.DriftNewBlock, Path: "shopping", Got: "array", CodeVersion: "1.514"}},
+		CacheErrors: []*luminati.CacheError{
+			{Op: "get", Key: "luminati-client-macbook-uk-mobile-json", Err: luminati.ErrCacheOpen},
+			{Op: "set", Key: "luminati-client-macbook-uk-mobile-json", Err: fmt.Errorf("connection refused")},
+		},
+		Stale: true,
+	}
+)
+
+// Setup serves the finder over an in-memory connection and
+// returns a Client connected to it.
+func (t *RPCTestSuite) Setup(f *finder) (*Client, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	NewServer(f).Register(srv)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	cc, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	t.NoError(err)
+
+	return NewClient(cc), func() {
+		_ = cc.Close()
+		srv.Stop()
+	}
+}
+
+func (t *RPCTestSuite) TestClient_JSON() {
+	f := &finder{serps: testSerps, meta: testMeta}
+	c, teardown := t.Setup(f)
+	defer teardown()
+
+	o := luminati.Options{Keyword: "macbook", Country: "us", Desktop: true, Params: url.Values{"hl": {"en", "fr"}}}
+	serps, meta, err := c.JSON(context.Background(), o)
+	t.NoError(err)
+	t.Equal(testSerps, serps)
+	t.Equal(testMeta, meta)
+	t.Equal([]luminati.Options{o}, f.calls)
+}
+
+func (t *RPCTestSuite) TestClient_HTML() {
+	c, teardown := t.Setup(&finder{html: "<html></html>", meta: testMeta})
+	defer teardown()
+
+	html, meta, err := c.HTML(context.Background(), luminati.Options{Keyword: "macbook"})
+	t.NoError(err)
+	t.Equal("<html></html>", html)
+	t.Equal(testMeta, meta)
+}
+
+func (t *RPCTestSuite) TestClient_CheckURL() {
+	c, teardown := t.Setup(&finder{serps: testSerps})
+	defer teardown()
+
+	domain, _, err := c.CheckURL(context.Background(), luminati.Options{Keyword: "macbook"}, "https://www.apple.com")
+	t.NoError(err)
+	t.Equal(testSerps.CheckURL("https://www.apple.com"), domain)
+
+	_, _, err = c.CheckURL(context.Background(), luminati.Options{Keyword: "macbook"}, "")
+	t.Contains(err.Error(), "no url")
+}
+
+func (t *RPCTestSuite) TestClient_Errors() {
+	tt := map[string]struct {
+		err  error
+		want error
+	}{
+		"No Keyword": {
+			luminati.ErrNoKeywordProvided,
+			luminati.ErrNoKeywordProvided,
+		},
+		"Timeout": {
+			luminati.ErrClientTimeout,
+			luminati.ErrClientTimeout,
+		},
+		"Cancelled": {
+			context.Canceled,
+			context.Canceled,
+		},
+		"Other": {
+			fmt.Errorf("luminati client request failed"),
+			fmt.Errorf("luminati client request failed"),
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			c, teardown := t.Setup(&finder{err: test.err})
+			defer teardown()
+
+			_, _, err := c.JSON(context.Background(), luminati.Options{Keyword: "error"})
+			t.Equal(test.want.Error(), err.Error())
+			if test.err != test.want {
+				return
+			}
+			t.Equal(test.want, err)
+
+			_, _, err = c.HTML(context.Background(), luminati.Options{Keyword: "error"})
+			t.Equal(test.want, err)
+		})
+	}
+}
+
+func (t *RPCTestSuite) TestClient_BreakerOpen() {
+	c, teardown := t.Setup(&finder{err: &luminati.BreakerError{Until: time.Now()}})
+	defer teardown()
+
+	_, _, err := c.JSON(context.Background(), luminati.Options{Keyword: "error"})
+	t.ErrorIs(err, luminati.ErrBreakerOpen)
+}
+
+func (t *RPCTestSuite) TestClient_Batch() {
+	f := &finder{serps: testSerps, meta: testMeta, err: fmt.Errorf("lookup error")}
+	c, teardown := t.Setup(f)
+	defer teardown()
+
+	opts := []luminati.Options{{Keyword: "macbook"}, {Keyword: "error"}, {Keyword: "ipad"}}
+	var results []BatchResult
+	err := c.Batch(context.Background(), opts, 2, func(r BatchResult) {
+		results = append(results, r)
+	})
+	t.NoError(err)
+	t.Len(results, 3)
+	t.Len(f.calls, 3)
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	t.Equal(testSerps, results[0].Serps)
+	t.Equal(testMeta, results[0].Meta)
+	t.EqualError(results[1].Err, "lookup error")
+	t.Equal(testSerps, results[2].Serps)
+}
+
+func (t *RPCTestSuite) TestClient_Batch_TooLarge() {
+	f := &finder{serps: testSerps}
+	c, teardown := t.Setup(f)
+	defer teardown()
+
+	opts := make([]luminati.Options, DefaultMaxBatch+1)
+	err := c.Batch(context.Background(), opts, 0, func(r BatchResult) {})
+	t.Contains(err.Error(), "101 options exceeds the maximum of 100")
+	t.Empty(f.calls)
+}
+
+func (t *RPCTestSuite) TestClient_Batch_Cancelled() {
+	c, teardown := t.Setup(&finder{serps: testSerps})
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.Batch(ctx, []luminati.Options{{Keyword: "macbook"}}, 0, func(r BatchResult) {})
+	t.Equal(context.Canceled, err)
+}
+
+func (t *RPCTestSuite) TestToStatus() {
+	tt := map[string]struct {
+		input error
+		want  codes.Code
+	}{
+		"Nil":       {nil, codes.OK},
+		"Keyword":   {luminati.ErrNoKeywordProvided, codes.InvalidArgument},
+		"Timeout":   {luminati.ErrClientTimeout, codes.DeadlineExceeded},
+		"Deadline":  {context.DeadlineExceeded, codes.DeadlineExceeded},
+		"Cancelled": {context.Canceled, codes.Canceled},
+		"Breaker":   {&luminati.BreakerError{}, codes.Unavailable},
+		"Other":     {fmt.Errorf("error"), codes.Unknown},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, status.Code(toStatus(test.input)))
+		})
+	}
+}
+
+func (t *RPCTestSuite) TestConvert_Empty() {
+	t.Equal(luminati.Serps{}, fromSerps(toSerps(luminati.Serps{})))
+	t.Equal(luminati.Meta{}, fromMeta(toMeta(luminati.Meta{})))
+	t.Equal(luminati.Domain{}, fromDomain(toDomain(luminati.Domain{})))
+	t.Equal(luminati.Options{}, fromOptions(toOptions(luminati.Options{})))
+	t.Equal(luminati.Serps{}, fromSerps(nil))
+}
+
+func (t *RPCTestSuite) TestConvert_NilCacheError() {
+	m := luminati.Meta{CacheErrors: []*luminati.CacheError{nil, {Op: "get", Key: "key"}}}
+	got := fromMeta(toMeta(m))
+	t.Equal(luminati.Meta{CacheErrors: []*luminati.CacheError{{Op: "get", Key: "key"}}}, got)
+	t.Equal("luminati cache get failed", got.CacheErrors[0].Error())
+}
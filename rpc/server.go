@@ -0,0 +1,152 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"github.com/lacuna-seo/luminati"
+	"github.com/lacuna-seo/luminati/rpc/luminatipb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+)
+
+const (
+	// DefaultConcurrency is the number of lookups performed
+	// at once by Batch when the request does not set one.
+	DefaultConcurrency = 5
+	// MaxConcurrency is the maximum number of lookups
+	// performed at once by Batch.
+	MaxConcurrency = 50
+	// DefaultMaxBatch is the maximum number of options in a
+	// single Batch request.
+	DefaultMaxBatch = 100
+)
+
+// Server implements luminatipb.KeywordFinderServer by
+// wrapping a luminati.KeywordFinder, such as a
+// *luminati.Client.
+type Server struct {
+	luminatipb.UnimplementedKeywordFinderServer
+	// MaxBatch is the maximum number of options in a single
+	// Batch request, larger requests are rejected.
+	MaxBatch int
+	finder   luminati.KeywordFinder
+}
+
+// NewServer creates a Server using the KeywordFinder for
+// lookups, accepting batches of up to DefaultMaxBatch.
+func NewServer(finder luminati.KeywordFinder) *Server {
+	return &Server{MaxBatch: DefaultMaxBatch, finder: finder}
+}
+
+// Register registers the Server with the gRPC server.
+func (s *Server) Register(srv grpc.ServiceRegistrar) {
+	luminatipb.RegisterKeywordFinderServer(srv, s)
+}
+
+// JSON implements luminatipb.KeywordFinderServer.
+func (s *Server) JSON(ctx context.Context, req *luminatipb.JSONRequest) (*luminatipb.JSONResponse, error) {
+	serps, meta, err := s.finder.JSON(ctx, fromOptions(req.GetOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &luminatipb.JSONResponse{Serps: toSerps(serps), Meta: toMeta(meta)}, nil
+}
+
+// HTML implements luminatipb.KeywordFinderServer.
+func (s *Server) HTML(ctx context.Context, req *luminatipb.HTMLRequest) (*luminatipb.HTMLResponse, error) {
+	html, meta, err := s.finder.HTML(ctx, fromOptions(req.GetOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &luminatipb.HTMLResponse{Html: html, Meta: toMeta(meta)}, nil
+}
+
+// CheckURL implements luminatipb.KeywordFinderServer.
+func (s *Server) CheckURL(ctx context.Context, req *luminatipb.CheckURLRequest) (*luminatipb.CheckURLResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no url")
+	}
+	serps, meta, err := s.finder.JSON(ctx, fromOptions(req.GetOptions()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &luminatipb.CheckURLResponse{Domain: toDomain(serps.CheckURL(req.GetUrl())), Meta: toMeta(meta)}, nil
+}
+
+// Batch implements luminatipb.KeywordFinderServer, streaming
+// each result as it completes. Failed lookups are sent with
+// an error rather than ending the stream.
+func (s *Server) Batch(req *luminatipb.BatchRequest, stream luminatipb.KeywordFinder_BatchServer) error {
+	if len(req.GetOptions()) > s.MaxBatch {
+		return status.Errorf(codes.InvalidArgument, "%d options exceeds the maximum of %d", len(req.GetOptions()), s.MaxBatch)
+	}
+
+	concurrency := int(req.GetConcurrency())
+	if concurrency == 0 {
+		concurrency = DefaultConcurrency
+	}
+	if concurrency > MaxConcurrency {
+		concurrency = MaxConcurrency
+	}
+
+	ctx := stream.Context()
+	jobs := make(chan int)
+	results := make(chan *luminatipb.BatchResponse)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results <- s.lookup(ctx, j, req.GetOptions()[j])
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := range req.GetOptions() {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var err error
+	for r := range results {
+		if err == nil {
+			err = stream.Send(r)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return toStatus(ctx.Err())
+}
+
+// lookup performs a single lookup of a batch.
+func (s *Server) lookup(ctx context.Context, index int, o *luminatipb.Options) *luminatipb.BatchResponse {
+	resp := &luminatipb.BatchResponse{Index: uint32(index)}
+	serps, meta, err := s.finder.JSON(ctx, fromOptions(o))
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Serps = toSerps(serps)
+	resp.Meta = toMeta(meta)
+	return resp
+}
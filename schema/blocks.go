@@ -0,0 +1,295 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type (
+	// General describes the search that was performed.
+	General struct {
+		SearchEngine string  `json:"search_engine"`
+		Query        string  `json:"query"`
+		ResultsCount int     `json:"results_cnt"`
+		SearchTime   float64 `json:"search_time"`
+		Language     string  `json:"language"`
+		Location     string  `json:"location"`
+		Mobile       bool    `json:"mobile"`
+		BasicView    bool    `json:"basic_view"`
+		SearchType   string  `json:"search_type"`
+		PageTitle    string  `json:"page_title"`
+		// CodeVersion is the version of the BrightData parser
+		// that produced the response.
+		CodeVersion string                     `json:"code_version"`
+		Timestamp   time.Time                  `json:"timestamp"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// Input is the request as received by BrightData.
+	Input struct {
+		OriginalURL string                     `json:"original_url"`
+		RequestID   string                     `json:"request_id,omitempty"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// Organic is a single organic result.
+	Organic struct {
+		Link            string                     `json:"link"`
+		DisplayLink     string                     `json:"display_link,omitempty"`
+		Title           string                     `json:"title"`
+		Description     string                     `json:"description"`
+		CachedLink      string                     `json:"cached_link,omitempty"`
+		SimilarLink     string                     `json:"similar_link,omitempty"`
+		Extensions      []Extension                `json:"extensions,omitempty"`
+		InfoDescription string                     `json:"info_description,omitempty"`
+		InfoLogo        string                     `json:"info_logo,omitempty"`
+		InfoSource      string                     `json:"info_source,omitempty"`
+		InfoLink        string                     `json:"info_link,omitempty"`
+		Image           string                     `json:"image,omitempty"`
+		ImageAlt        string                     `json:"image_alt,omitempty"`
+		ImageURL        string                     `json:"image_url,omitempty"`
+		Duration        string                     `json:"duration,omitempty"`
+		DurationSec     int                        `json:"duration_sec,omitempty"`
+		Rank            int                        `json:"rank"`
+		GlobalRank      int                        `json:"global_rank"`
+		Extra           map[string]json.RawMessage `json:"-"`
+	}
+	// Extension is a site link, rating, fact or other
+	// enhancement of an organic result or ad.
+	Extension struct {
+		Type   string                     `json:"type"`
+		Text   string                     `json:"text,omitempty"`
+		Link   string                     `json:"link,omitempty"`
+		Rank   int                        `json:"rank,omitempty"`
+		Inline bool                       `json:"inline,omitempty"`
+		Key    string                     `json:"key,omitempty"`
+		Value  []Value                    `json:"value,omitempty"`
+		Extra  map[string]json.RawMessage `json:"-"`
+	}
+	// Value is a linked value of a fact.
+	Value struct {
+		Text  string                     `json:"text"`
+		Link  string                     `json:"link,omitempty"`
+		Extra map[string]json.RawMessage `json:"-"`
+	}
+	// Ad is a single top or bottom paid result.
+	Ad struct {
+		Link         string                     `json:"link"`
+		DisplayLink  string                     `json:"display_link,omitempty"`
+		ReferralLink string                     `json:"referral_link,omitempty"`
+		Title        string                     `json:"title"`
+		Description  string                     `json:"description"`
+		Phone        string                     `json:"phone,omitempty"`
+		Extensions   []Extension                `json:"extensions,omitempty"`
+		Rank         int                        `json:"rank"`
+		GlobalRank   int                        `json:"global_rank"`
+		Extra        map[string]json.RawMessage `json:"-"`
+	}
+	// Snippet is a featured snippet or answer box.
+	Snippet struct {
+		Type        string                     `json:"type,omitempty"`
+		Title       string                     `json:"title,omitempty"`
+		Description string                     `json:"description,omitempty"`
+		Text        string                     `json:"text,omitempty"`
+		Answer      string                     `json:"answer,omitempty"`
+		List        []Text                     `json:"list,omitempty"`
+		Table       [][]Text                   `json:"table,omitempty"`
+		Link        string                     `json:"link,omitempty"`
+		DisplayLink string                     `json:"display_link,omitempty"`
+		Rank        int                        `json:"rank"`
+		GlobalRank  int                        `json:"global_rank"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// Knowledge is the knowledge panel.
+	Knowledge struct {
+		Name                string                     `json:"name"`
+		Subtitle            string                     `json:"subtitle,omitempty"`
+		Summary             string                     `json:"summary,omitempty"`
+		Description         string                     `json:"description,omitempty"`
+		DescriptionSource   string                     `json:"description_source,omitempty"`
+		DescriptionLink     string                     `json:"description_link,omitempty"`
+		Address             string                     `json:"address,omitempty"`
+		Phone               string                     `json:"phone,omitempty"`
+		Site                string                     `json:"site,omitempty"`
+		Fid                 string                     `json:"fid,omitempty"`
+		ReviewsCount        int                        `json:"reviews_cnt,omitempty"`
+		MapsLink            string                     `json:"maps_link,omitempty"`
+		Latitude            float64                    `json:"latitude,omitempty"`
+		Longitude           float64                    `json:"longitude,omitempty"`
+		Zoom                int                        `json:"zoom,omitempty"`
+		MerchantDescription string                     `json:"merchant_description,omitempty"`
+		OpenHours           []OpenHours                `json:"open_hours,omitempty"`
+		Images              []KnowledgeImage           `json:"images,omitempty"`
+		Facts               []Fact                     `json:"facts,omitempty"`
+		Widgets             []Widget                   `json:"widgets,omitempty"`
+		Extra               map[string]json.RawMessage `json:"-"`
+	}
+	// OpenHours are the opening hours of a single day.
+	OpenHours struct {
+		Day   string                     `json:"day"`
+		Hours string                     `json:"hours"`
+		Extra map[string]json.RawMessage `json:"-"`
+	}
+	// KnowledgeImage is an image of the knowledge panel.
+	KnowledgeImage struct {
+		Link        string                     `json:"link,omitempty"`
+		Image       string                     `json:"image"`
+		ImageAlt    string                     `json:"image_alt,omitempty"`
+		ImageURL    string                     `json:"image_url,omitempty"`
+		ImageBase64 string                     `json:"image_base64,omitempty"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// Fact is a key and value pair of the knowledge panel.
+	Fact struct {
+		Key       string                     `json:"key"`
+		KeyLink   string                     `json:"key_link,omitempty"`
+		Predicate string                     `json:"predicate,omitempty"`
+		Value     []Value                    `json:"value,omitempty"`
+		Extra     map[string]json.RawMessage `json:"-"`
+	}
+	// Widget is a carousel of the knowledge panel, such as
+	// "People also search for".
+	Widget struct {
+		Type       string                     `json:"type"`
+		Key        string                     `json:"key,omitempty"`
+		KeyLink    string                     `json:"key_link,omitempty"`
+		Predicate  string                     `json:"predicate,omitempty"`
+		Title      string                     `json:"title,omitempty"`
+		Items      []WidgetItem               `json:"items,omitempty"`
+		Rank       int                        `json:"rank"`
+		GlobalRank int                        `json:"global_rank"`
+		Extra      map[string]json.RawMessage `json:"-"`
+	}
+	// WidgetItem is a single item of a knowledge panel
+	// widget.
+	WidgetItem struct {
+		Title       string                     `json:"title,omitempty"`
+		Name        string                     `json:"name,omitempty"`
+		Link        string                     `json:"link"`
+		Image       string                     `json:"image,omitempty"`
+		ImageAlt    string                     `json:"image_alt,omitempty"`
+		ImageBase64 string                     `json:"image_base64,omitempty"`
+		Rank        int                        `json:"rank"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// SnackPackMap is the map shown above the local pack.
+	SnackPackMap struct {
+		Image       string                     `json:"image"`
+		ImageAlt    string                     `json:"image_alt,omitempty"`
+		ImageBase64 string                     `json:"image_base64,omitempty"`
+		Link        string                     `json:"link"`
+		Latitude    float64                    `json:"latitude"`
+		Longitude   float64                    `json:"longitude"`
+		Altitude    int                        `json:"altitude"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// SnackPack is a single business of the local pack.
+	SnackPack struct {
+		Cid               string                     `json:"cid"`
+		Name              string                     `json:"name"`
+		Image             string                     `json:"image,omitempty"`
+		ImageBase64       string                     `json:"image_base64,omitempty"`
+		Rating            float64                    `json:"rating,omitempty"`
+		ReviewsCount      int                        `json:"reviews_cnt,omitempty"`
+		Type              string                     `json:"type,omitempty"`
+		Price             string                     `json:"price,omitempty"`
+		WorkStatus        string                     `json:"work_status,omitempty"`
+		WorkStatusDetails string                     `json:"work_status_details,omitempty"`
+		Address           string                     `json:"address,omitempty"`
+		Phone             string                     `json:"phone,omitempty"`
+		Site              string                     `json:"site,omitempty"`
+		Tags              []string                   `json:"tags,omitempty"`
+		Rank              int                        `json:"rank"`
+		GlobalRank        int                        `json:"global_rank"`
+		Extra             map[string]json.RawMessage `json:"-"`
+	}
+	// Image is a single result of the image pack.
+	Image struct {
+		Image      string                     `json:"image"`
+		ImageAlt   string                     `json:"image_alt,omitempty"`
+		ImageURL   string                     `json:"image_url,omitempty"`
+		Tag        string                     `json:"tag,omitempty"`
+		Link       string                     `json:"link,omitempty"`
+		SourceLink string                     `json:"source_link,omitempty"`
+		Source     string                     `json:"source,omitempty"`
+		Rank       int                        `json:"rank"`
+		GlobalRank int                        `json:"global_rank"`
+		Extra      map[string]json.RawMessage `json:"-"`
+	}
+	// CarouselItem is a single item of a Top Stories, video
+	// or Twitter carousel.
+	CarouselItem struct {
+		Title       string                     `json:"title"`
+		Source      string                     `json:"source,omitempty"`
+		Platform    string                     `json:"platform,omitempty"`
+		Age         string                     `json:"age,omitempty"`
+		Date        string                     `json:"date,omitempty"`
+		Duration    string                     `json:"duration,omitempty"`
+		DurationSec int                        `json:"duration_sec,omitempty"`
+		Account     string                     `json:"account,omitempty"`
+		Author      string                     `json:"author,omitempty"`
+		Link        string                     `json:"link"`
+		Rank        int                        `json:"rank"`
+		GlobalRank  int                        `json:"global_rank"`
+		Extra       map[string]json.RawMessage `json:"-"`
+	}
+	// Question is a single People Also Ask question.
+	Question struct {
+		Question          string                     `json:"question"`
+		QuestionLink      string                     `json:"question_link,omitempty"`
+		AnswerSource      string                     `json:"answer_source,omitempty"`
+		AnswerLink        string                     `json:"answer_link,omitempty"`
+		AnswerDisplayLink string                     `json:"answer_display_link,omitempty"`
+		AnswerHTML        string                     `json:"answer_html,omitempty"`
+		Answers           []Answer                   `json:"answers,omitempty"`
+		Rank              int                        `json:"rank"`
+		GlobalRank        int                        `json:"global_rank"`
+		Extra             map[string]json.RawMessage `json:"-"`
+	}
+	// Answer is a part of a People Also Ask answer.
+	Answer struct {
+		Type  string                     `json:"type"`
+		Title string                     `json:"title,omitempty"`
+		Value *Value                     `json:"value,omitempty"`
+		Items []AnswerItem               `json:"items,omitempty"`
+		Rank  int                        `json:"rank"`
+		Extra map[string]json.RawMessage `json:"-"`
+	}
+	// AnswerItem is a single value of an Answer.
+	AnswerItem struct {
+		Value string                     `json:"value"`
+		Rank  int                        `json:"rank"`
+		Extra map[string]json.RawMessage `json:"-"`
+	}
+	// Related is a single related search or refinement chip.
+	Related struct {
+		Text       string                     `json:"text"`
+		Link       string                     `json:"link,omitempty"`
+		ListGroup  bool                       `json:"list_group"`
+		Expanded   bool                       `json:"expanded,omitempty"`
+		Image      string                     `json:"image,omitempty"`
+		ImageAlt   string                     `json:"image_alt,omitempty"`
+		ImageURL   string                     `json:"image_url,omitempty"`
+		Rank       int                        `json:"rank"`
+		GlobalRank int                        `json:"global_rank"`
+		Extra      map[string]json.RawMessage `json:"-"`
+	}
+	// Pagination links to the other pages of results.
+	Pagination struct {
+		CurrentPage   int                        `json:"current_page"`
+		NextPageLink  string                     `json:"next_page_link,omitempty"`
+		NextPageStart int                        `json:"next_page_start,omitempty"`
+		NextPage      int                        `json:"next_page,omitempty"`
+		Pages         []Page                     `json:"pages,omitempty"`
+		Extra         map[string]json.RawMessage `json:"-"`
+	}
+	// Page is a single page of Pagination.
+	Page struct {
+		Page  int                        `json:"page"`
+		Link  string                     `json:"link"`
+		Start int                        `json:"start"`
+		Extra map[string]json.RawMessage `json:"-"`
+	}
+)
@@ -0,0 +1,285 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+// The methods below keep the unknown fields of each type in
+// its Extra map when decoding, and write them back out when
+// encoding.
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *General) UnmarshalJSON(buf []byte) error {
+	type plain General
+	return unmarshal(buf, (*plain)(g), &g.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g General) MarshalJSON() ([]byte, error) {
+	type plain General
+	return marshal(plain(g), g.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Input) UnmarshalJSON(buf []byte) error {
+	type plain Input
+	return unmarshal(buf, (*plain)(i), &i.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Input) MarshalJSON() ([]byte, error) {
+	type plain Input
+	return marshal(plain(i), i.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Organic) UnmarshalJSON(buf []byte) error {
+	type plain Organic
+	return unmarshal(buf, (*plain)(o), &o.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Organic) MarshalJSON() ([]byte, error) {
+	type plain Organic
+	return marshal(plain(o), o.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Extension) UnmarshalJSON(buf []byte) error {
+	type plain Extension
+	return unmarshal(buf, (*plain)(e), &e.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e Extension) MarshalJSON() ([]byte, error) {
+	type plain Extension
+	return marshal(plain(e), e.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Value) UnmarshalJSON(buf []byte) error {
+	type plain Value
+	return unmarshal(buf, (*plain)(v), &v.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Value) MarshalJSON() ([]byte, error) {
+	type plain Value
+	return marshal(plain(v), v.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Ad) UnmarshalJSON(buf []byte) error {
+	type plain Ad
+	return unmarshal(buf, (*plain)(a), &a.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a Ad) MarshalJSON() ([]byte, error) {
+	type plain Ad
+	return marshal(plain(a), a.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Snippet) UnmarshalJSON(buf []byte) error {
+	type plain Snippet
+	return unmarshal(buf, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Snippet) MarshalJSON() ([]byte, error) {
+	type plain Snippet
+	return marshal(plain(s), s.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *Knowledge) UnmarshalJSON(buf []byte) error {
+	type plain Knowledge
+	return unmarshal(buf, (*plain)(k), &k.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k Knowledge) MarshalJSON() ([]byte, error) {
+	type plain Knowledge
+	return marshal(plain(k), k.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OpenHours) UnmarshalJSON(buf []byte) error {
+	type plain OpenHours
+	return unmarshal(buf, (*plain)(o), &o.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OpenHours) MarshalJSON() ([]byte, error) {
+	type plain OpenHours
+	return marshal(plain(o), o.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *KnowledgeImage) UnmarshalJSON(buf []byte) error {
+	type plain KnowledgeImage
+	return unmarshal(buf, (*plain)(k), &k.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k KnowledgeImage) MarshalJSON() ([]byte, error) {
+	type plain KnowledgeImage
+	return marshal(plain(k), k.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Fact) UnmarshalJSON(buf []byte) error {
+	type plain Fact
+	return unmarshal(buf, (*plain)(f), &f.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Fact) MarshalJSON() ([]byte, error) {
+	type plain Fact
+	return marshal(plain(f), f.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *Widget) UnmarshalJSON(buf []byte) error {
+	type plain Widget
+	return unmarshal(buf, (*plain)(w), &w.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (w Widget) MarshalJSON() ([]byte, error) {
+	type plain Widget
+	return marshal(plain(w), w.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *WidgetItem) UnmarshalJSON(buf []byte) error {
+	type plain WidgetItem
+	return unmarshal(buf, (*plain)(w), &w.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (w WidgetItem) MarshalJSON() ([]byte, error) {
+	type plain WidgetItem
+	return marshal(plain(w), w.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SnackPackMap) UnmarshalJSON(buf []byte) error {
+	type plain SnackPackMap
+	return unmarshal(buf, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s SnackPackMap) MarshalJSON() ([]byte, error) {
+	type plain SnackPackMap
+	return marshal(plain(s), s.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SnackPack) UnmarshalJSON(buf []byte) error {
+	type plain SnackPack
+	return unmarshal(buf, (*plain)(s), &s.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s SnackPack) MarshalJSON() ([]byte, error) {
+	type plain SnackPack
+	return marshal(plain(s), s.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Image) UnmarshalJSON(buf []byte) error {
+	type plain Image
+	return unmarshal(buf, (*plain)(i), &i.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Image) MarshalJSON() ([]byte, error) {
+	type plain Image
+	return marshal(plain(i), i.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CarouselItem) UnmarshalJSON(buf []byte) error {
+	type plain CarouselItem
+	return unmarshal(buf, (*plain)(c), &c.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c CarouselItem) MarshalJSON() ([]byte, error) {
+	type plain CarouselItem
+	return marshal(plain(c), c.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Question) UnmarshalJSON(buf []byte) error {
+	type plain Question
+	return unmarshal(buf, (*plain)(q), &q.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q Question) MarshalJSON() ([]byte, error) {
+	type plain Question
+	return marshal(plain(q), q.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Answer) UnmarshalJSON(buf []byte) error {
+	type plain Answer
+	return unmarshal(buf, (*plain)(a), &a.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	type plain Answer
+	return marshal(plain(a), a.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AnswerItem) UnmarshalJSON(buf []byte) error {
+	type plain AnswerItem
+	return unmarshal(buf, (*plain)(a), &a.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AnswerItem) MarshalJSON() ([]byte, error) {
+	type plain AnswerItem
+	return marshal(plain(a), a.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Related) UnmarshalJSON(buf []byte) error {
+	type plain Related
+	return unmarshal(buf, (*plain)(r), &r.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r Related) MarshalJSON() ([]byte, error) {
+	type plain Related
+	return marshal(plain(r), r.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Pagination) UnmarshalJSON(buf []byte) error {
+	type plain Pagination
+	return unmarshal(buf, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Pagination) MarshalJSON() ([]byte, error) {
+	type plain Pagination
+	return marshal(plain(p), p.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Page) UnmarshalJSON(buf []byte) error {
+	type plain Page
+	return unmarshal(buf, (*plain)(p), &p.Extra)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Page) MarshalJSON() ([]byte, error) {
+	type plain Page
+	return marshal(plain(p), p.Extra)
+}
@@ -0,0 +1,287 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DriftKind is the type of change reported by Detect.
+type DriftKind string
+
+const (
+	// DriftNewBlock is a top level block that the schema does
+	// not define.
+	DriftNewBlock DriftKind = "new_block"
+	// DriftNewField is a field within a known block that the
+	// schema does not define.
+	DriftNewField DriftKind = "new_field"
+	// DriftMissingField is a field that the schema expects in
+	// every payload, i.e. one without omitempty, that is
+	// absent.
+	DriftMissingField DriftKind = "missing_field"
+	// DriftTypeChange is a field whose JSON type differs from
+	// the schema, such as a number received as a string.
+	DriftTypeChange DriftKind = "type_change"
+)
+
+type (
+	// Drift is a single difference between a payload and the
+	// schema.
+	Drift struct {
+		Kind DriftKind `json:"kind"`
+		// Path is the location of the field, with array
+		// indexes collapsed, e.g. organic[].extensions[].type.
+		Path     string `json:"path"`
+		Expected string `json:"expected,omitempty"`
+		Got      string `json:"got,omitempty"`
+		// CodeVersion is the general.code_version of the
+		// payload the drift was found in.
+		CodeVersion string `json:"code_version,omitempty"`
+	}
+	// DriftSummary is the number of times the same drift
+	// occurred across a batch of payloads, as returned by
+	// Summarise.
+	DriftSummary struct {
+		Kind         DriftKind `json:"kind"`
+		Path         string    `json:"path"`
+		Expected     string    `json:"expected,omitempty"`
+		Got          string    `json:"got,omitempty"`
+		Count        int       `json:"count"`
+		CodeVersions []string  `json:"code_versions,omitempty"`
+	}
+)
+
+// String implements fmt.Stringer on the drift.
+func (d Drift) String() string {
+	s := fmt.Sprintf("%s %s", d.Kind, d.Path)
+	if d.Kind == DriftTypeChange {
+		s += fmt.Sprintf(": expected %s, got %s", d.Expected, d.Got)
+	}
+	if d.CodeVersion != "" {
+		s += fmt.Sprintf(" (code version %s)", d.CodeVersion)
+	}
+	return s
+}
+
+// Detect compares the key set and field types of a raw
+// BrightData payload against the schema. Each drift is
+// reported once per payload, ordered by path.
+//
+// Returns an error if the buffer is not a JSON object.
+func Detect(buf []byte) ([]Drift, error) {
+	m := map[string]json.RawMessage{}
+	err := json.Unmarshal(buf, &m)
+	if err != nil {
+		return nil, errors.Wrap(err, "error detecting drift")
+	}
+
+	d := detector{seen: make(map[string]bool)}
+	var general struct {
+		CodeVersion string `json:"code_version"`
+	}
+	_ = json.Unmarshal(m["general"], &general) // Reported by the walk below
+	d.version = general.CodeVersion
+
+	d.object("", m, reflect.TypeOf(Response{}), true)
+
+	sort.SliceStable(d.drift, func(i, j int) bool {
+		return d.drift[i].Path < d.drift[j].Path
+	})
+
+	return d.drift, nil
+}
+
+// Summarise groups the drift of a batch of payloads by kind,
+// path and type, sorted by the number of occurrences.
+func Summarise(drift []Drift) []DriftSummary {
+	index := make(map[string]int)
+	var summaries []DriftSummary
+	for _, d := range drift {
+		key := string(d.Kind) + "|" + d.Path + "|" + d.Got
+		i, ok := index[key]
+		if !ok {
+			i = len(summaries)
+			index[key] = i
+			summaries = append(summaries, DriftSummary{Kind: d.Kind, Path: d.Path, Expected: d.Expected, Got: d.Got})
+		}
+		summaries[i].Count++
+		if d.CodeVersion != "" && !contains(summaries[i].CodeVersions, d.CodeVersion) {
+			summaries[i].CodeVersions = append(summaries[i].CodeVersions, d.CodeVersion)
+		}
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].Count != summaries[j].Count {
+			return summaries[i].Count > summaries[j].Count
+		}
+		return summaries[i].Path < summaries[j].Path
+	})
+	return summaries
+}
+
+// detector walks a payload alongside the schema types.
+type detector struct {
+	version string
+	seen    map[string]bool
+	drift   []Drift
+}
+
+// report adds a drift if it has not already been reported.
+func (d *detector) report(kind DriftKind, path, expected, got string) {
+	key := string(kind) + "|" + path
+	if d.seen[key] {
+		return
+	}
+	d.seen[key] = true
+	d.drift = append(d.drift, Drift{Kind: kind, Path: path, Expected: expected, Got: got, CodeVersion: d.version})
+}
+
+// object compares the fields of a JSON object against the
+// struct type. Top level blocks may be a single object where
+// the schema defines a slice.
+func (d *detector) object(path string, m map[string]json.RawMessage, t reflect.Type, top bool) {
+	fields := fieldIndex(t)
+	for key, raw := range m {
+		i, ok := fields[key]
+		if !ok {
+			if top {
+				d.report(DriftNewBlock, key, "", "")
+			} else {
+				d.report(DriftNewField, join(path, key), "", "")
+			}
+			continue
+		}
+		d.value(join(path, key), raw, t.Field(i).Type, top)
+	}
+	for _, key := range requiredFields(t) {
+		if _, ok := m[key]; !ok {
+			d.report(DriftMissingField, join(path, key), "", "")
+		}
+	}
+}
+
+// value compares a single JSON value against the type.
+func (d *detector) value(path string, raw json.RawMessage, t reflect.Type, oneOrMany bool) {
+	got := jsonKind(raw)
+	if got == "null" {
+		return
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Slice && oneOrMany && got == "object" {
+		d.value(path, raw, t.Elem(), false)
+		return
+	}
+
+	expected := expectedKind(t)
+	if got != expected && !(t == reflect.TypeOf(Text("")) && got == "object") {
+		d.report(DriftTypeChange, path, expected, got)
+		return
+	}
+
+	switch {
+	case t.Kind() == reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return
+		}
+		for _, item := range items {
+			d.value(path+"[]", item, t.Elem(), false)
+		}
+	case t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{}):
+		m := map[string]json.RawMessage{}
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return
+		}
+		d.object(path, m, t, false)
+	}
+}
+
+// expectedKind returns the JSON type of the Go type.
+func expectedKind(t reflect.Type) string {
+	if t == reflect.TypeOf(time.Time{}) {
+		return "string"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// jsonKind returns the JSON type of the raw value.
+func jsonKind(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return "null"
+	}
+	switch raw[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// requiredFields returns the JSON names of the struct's
+// fields that are not tagged omitempty.
+func requiredFields(t reflect.Type) []string {
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")
+		if tag[0] == "" || tag[0] == "-" || t.Field(i).PkgPath != "" {
+			continue
+		}
+		if len(tag) > 1 && tag[1] == "omitempty" {
+			continue
+		}
+		required = append(required, tag[0])
+	}
+	return required
+}
+
+// join appends the key to the path.
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// contains returns true if the string is in the slice.
+func contains(s []string, v string) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
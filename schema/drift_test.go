@@ -0,0 +1,105 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func (t *SchemaTestSuite) TestDetect() {
+	tt := map[string]struct {
+		input string
+		want  interface{}
+	}{
+		"Error": {
+			`[]`,
+			"error detecting drift",
+		},
+		"None": {
+			`{"general": {"search_engine": "google", "query": "pizza", "results_cnt": 1, "search_time": 0.5, "language": "en", "location": "", "mobile": false, "basic_view": false, "search_type": "text", "page_title": "", "code_version": "1.6", "timestamp": "2021-11-04T07:43:15.189Z"}, "input": {"original_url": ""}, "organic": null}`,
+			[]Drift(nil),
+		},
+		"Drift": {
+			`{
+				"general": {"code_version": "1.6"},
+				"organic": [
+					{"link": "https://www.apple.com", "title": "Apple", "description": "", "rank": "1", "global_rank": 1, "badge": "new"},
+					{"link": "https://www.bestbuy.com", "title": "Best Buy", "description": "", "rank": "2", "global_rank": 2, "extensions": [{"type": "site_link", "text": 1}]}
+				],
+				"featured_snippets": {"list": ["Dough", {"text": "Sauce"}], "rank": 1, "global_rank": 1},
+				"shopping": []
+			}`,
+			[]Drift{
+				{Kind: DriftMissingField, Path: "general.basic_view", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.language", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.location", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.mobile", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.page_title", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.query", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.results_cnt", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.search_engine", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.search_time", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.search_type", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "general.timestamp", CodeVersion: "1.6"},
+				{Kind: DriftMissingField, Path: "input", CodeVersion: "1.6"},
+				{Kind: DriftNewField, Path: "organic[].badge", CodeVersion: "1.6"},
+				{Kind: DriftTypeChange, Path: "organic[].extensions[].text", Expected: "string", Got: "number", CodeVersion: "1.6"},
+				{Kind: DriftTypeChange, Path: "organic[].rank", Expected: "number", Got: "string", CodeVersion: "1.6"},
+				{Kind: DriftNewBlock, Path: "shopping", CodeVersion: "1.6"},
+			},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got, err := Detect([]byte(test.input))
+			if err != nil {
+				t.Contains(err.Error(), test.want)
+				return
+			}
+			t.Equal(test.want, got)
+		})
+	}
+}
+
+func (t *SchemaTestSuite) TestDetect_Fixture() {
+	buf, err := os.ReadFile(filepath.Join("..", "testdata", "response.json"))
+	t.NoError(err)
+
+	got, err := Detect(buf)
+	t.NoError(err)
+	t.Empty(got)
+}
+
+func (t *SchemaTestSuite) TestSummarise() {
+	drift := []Drift{
+		{Kind: DriftNewBlock, Path: "shopping", CodeVersion: "1.6"},
+		{Kind: DriftTypeChange, Path: "organic[].rank", Expected: "number", Got: "string", CodeVersion: "1.6"},
+		{Kind: DriftTypeChange, Path: "organic[].rank", Expected: "number", Got: "string", CodeVersion: "1.7"},
+		{Kind: DriftTypeChange, Path: "organic[].rank", Expected: "number", Got: "string", CodeVersion: "1.7"},
+	}
+	t.Equal([]DriftSummary{
+		{Kind: DriftTypeChange, Path: "organic[].rank", Expected: "number", Got: "string", Count: 3, CodeVersions: []string{"1.6", "1.7"}},
+		{Kind: DriftNewBlock, Path: "shopping", Count: 1, CodeVersions: []string{"1.6"}},
+	}, Summarise(drift))
+	t.Nil(Summarise(nil))
+}
+
+func (t *SchemaTestSuite) TestDrift_String() {
+	tt := map[string]struct {
+		input Drift
+		want  string
+	}{
+		"New Block":   {Drift{Kind: DriftNewBlock, Path: "shopping"}, "new_block shopping"},
+		"Type Change": {Drift{Kind: DriftTypeChange, Path: "organic[].rank", Expected: "number", Got: "string", CodeVersion: "1.6"}, "type_change organic[].rank: expected number, got string (code version 1.6)"},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, test.input.String())
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package schema defines the strongly typed JSON response
+// returned by the BrightData (Luminati) SERP API.
+//
+// Every type keeps the fields it does not know about in an
+// Extra overflow map, and marshals them back out again, so
+// upstream schema changes can be detected without losing
+// data.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Version is the version of the schema defined by this
+// package. It is incremented whenever a field is added,
+// removed or changes type.
+const Version = 1
+
+// Response is a complete BrightData SERP response. Blocks
+// that BrightData returns either as a single object or as an
+// array are always decoded to a slice.
+type Response struct {
+	General          General        `json:"general"`
+	Input            Input          `json:"input"`
+	Organic          []Organic      `json:"organic,omitempty"`
+	TopAds           []Ad           `json:"top_ads,omitempty"`
+	BottomAds        []Ad           `json:"bottom_ads,omitempty"`
+	FeaturedSnippets []Snippet      `json:"featured_snippets,omitempty"`
+	AnswerBox        []Snippet      `json:"answer_box,omitempty"`
+	Knowledge        *Knowledge     `json:"knowledge,omitempty"`
+	SnackPackMap     *SnackPackMap  `json:"snack_pack_map,omitempty"`
+	SnackPack        []SnackPack    `json:"snack_pack,omitempty"`
+	Images           []Image        `json:"images,omitempty"`
+	TopStories       []CarouselItem `json:"top_stories,omitempty"`
+	Videos           []CarouselItem `json:"videos,omitempty"`
+	Twitter          []CarouselItem `json:"twitter,omitempty"`
+	PeopleAlsoAsk    []Question     `json:"people_also_ask,omitempty"`
+	Related          []Related      `json:"related,omitempty"`
+	Pagination       *Pagination    `json:"pagination,omitempty"`
+	// Extra holds the blocks not defined above, as well as
+	// any known block that failed to decode. For an array
+	// block only the items that failed are kept, as an array.
+	Extra map[string]json.RawMessage `json:"-"`
+	// Invalid are the names of known blocks that failed to
+	// decode in whole or in part, their raw value or failed
+	// items are kept in Extra.
+	Invalid []string `json:"-"`
+}
+
+// Parse decodes a BrightData response. An error is only
+// returned if the buffer is not a JSON object, blocks and
+// items that fail to decode are recorded in Response.Invalid.
+// Fields with an unexpected type are left empty and kept in
+// the Extra map of the item they belong to.
+func Parse(buf []byte) (Response, error) {
+	r := Response{}
+	err := json.Unmarshal(buf, &r)
+	if err != nil {
+		return Response{}, errors.Wrap(err, "error parsing response")
+	}
+	return r, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Each block, and
+// each item of an array block, is decoded independently so a
+// single malformed item does not fail the whole response.
+func (r *Response) UnmarshalJSON(buf []byte) error {
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return err
+	}
+
+	*r = Response{}
+	v := reflect.ValueOf(r).Elem()
+	fields := fieldIndex(v.Type())
+
+	for key, raw := range m {
+		i, ok := fields[key]
+		if !ok {
+			r.setExtra(key, raw)
+			continue
+		}
+		f := v.Field(i)
+		invalid, err := decodeItems(raw, f)
+		if err != nil {
+			r.Invalid = append(r.Invalid, key)
+			r.setExtra(key, raw)
+			continue
+		}
+		if invalid != nil {
+			r.Invalid = append(r.Invalid, key)
+			r.setExtra(key, invalid)
+		}
+	}
+
+	sort.Strings(r.Invalid)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, writing the Extra
+// blocks alongside the known ones.
+func (r Response) MarshalJSON() ([]byte, error) {
+	type plain Response
+	return marshal(plain(r), r.Extra)
+}
+
+// setExtra adds a raw block to the overflow map.
+func (r *Response) setExtra(key string, raw json.RawMessage) {
+	if r.Extra == nil {
+		r.Extra = make(map[string]json.RawMessage)
+	}
+	r.Extra[key] = raw
+}
+
+// Text is text received either as a plain string or as an
+// object with a text key.
+type Text string
+
+// UnmarshalJSON implements json.Unmarshaler for strings and
+// objects with a text key.
+func (t *Text) UnmarshalJSON(buf []byte) error {
+	var s string
+	if err := json.Unmarshal(buf, &s); err == nil {
+		*t = Text(s)
+		return nil
+	}
+	var o struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(buf, &o); err != nil {
+		return err
+	}
+	*t = Text(o.Text)
+	return nil
+}
+
+// DecodeBlock unmarshals a raw block into v. If v is a
+// pointer to a slice and the block is a single object, it is
+// decoded as a slice of one.
+func DecodeBlock(raw json.RawMessage, v interface{}) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+	if raw[0] == '{' && reflect.TypeOf(v).Elem().Kind() == reflect.Slice {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// decodeItems decodes a raw block into the field. The items
+// of an array block are decoded one by one, and those that
+// fail are returned as an array so the rest are kept. An
+// error is returned if the block as a whole does not decode.
+func decodeItems(raw json.RawMessage, f reflect.Value) (json.RawMessage, error) {
+	raw = bytes.TrimSpace(raw)
+	if f.Kind() != reflect.Slice || len(raw) == 0 || raw[0] != '[' {
+		ptr := reflect.New(f.Type())
+		if err := DecodeBlock(raw, ptr.Interface()); err != nil {
+			return nil, err
+		}
+		f.Set(ptr.Elem())
+		return nil, nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+
+	var invalid []json.RawMessage
+	for _, item := range items {
+		ptr := reflect.New(f.Type().Elem())
+		if err := json.Unmarshal(item, ptr.Interface()); err != nil {
+			invalid = append(invalid, item)
+			continue
+		}
+		f.Set(reflect.Append(f, ptr.Elem()))
+	}
+	if invalid == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(invalid)
+}
+
+// unmarshal decodes buf into v, a pointer to a struct, and
+// sets extra to the fields of buf that v does not define.
+// Fields that fail to decode, such as a number received as a
+// string, are left empty and kept in extra.
+func unmarshal(buf []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return err
+	}
+	s := reflect.ValueOf(v).Elem()
+	s.Set(reflect.Zero(s.Type()))
+	fields := fieldIndex(s.Type())
+	*extra = nil
+	for key, raw := range m {
+		if i, ok := fields[key]; ok {
+			ptr := reflect.New(s.Field(i).Type())
+			if err := json.Unmarshal(raw, ptr.Interface()); err == nil {
+				s.Field(i).Set(ptr.Elem())
+				continue
+			}
+		}
+		if *extra == nil {
+			*extra = make(map[string]json.RawMessage)
+		}
+		(*extra)[key] = raw
+	}
+	return nil
+}
+
+// marshal encodes v, a struct, merging in the extra fields.
+// Extra fields replace those of v that failed to decode, and
+// extra items are appended to an array that partly decoded.
+func marshal(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	buf, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return buf, err
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return nil, err
+	}
+	for key, raw := range extra {
+		var known, items []json.RawMessage
+		if json.Unmarshal(m[key], &known) == nil && len(known) > 0 && json.Unmarshal(raw, &items) == nil {
+			raw, err = json.Marshal(append(known, items...))
+			if err != nil {
+				return nil, err
+			}
+		}
+		m[key] = raw
+	}
+	return json.Marshal(m)
+}
+
+// fieldCache caches the JSON field names of each struct type.
+var fieldCache sync.Map
+
+// fieldIndex returns the JSON names of the struct's fields
+// mapped to their index. Fields tagged "-" are excluded.
+func fieldIndex(t reflect.Type) map[string]int {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.(map[string]int)
+	}
+	index := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" || f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		index[name] = i
+	}
+	fieldCache.Store(t, index)
+	return index
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// SchemaTestSuite defines the helper used for schema
+// testing.
+type SchemaTestSuite struct {
+	suite.Suite
+}
+
+// TestSchema asserts testing has begun.
+func TestSchema(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (t *SchemaTestSuite) TestParse() {
+	buf, err := os.ReadFile(filepath.Join("..", "testdata", "response.json"))
+	t.NoError(err)
+
+	got, err := Parse(buf)
+	t.NoError(err)
+	t.Empty(got.Invalid)
+	t.Nil(got.Extra)
+
+	t.Equal("1.514", got.General.CodeVersion)
+	t.Equal("pizza", got.General.Query)
+	t.Len(got.Organic, 10)
+	t.Len(got.Images, 10)
+	t.Len(got.SnackPack, 3)
+	t.Len(got.PeopleAlsoAsk, 4)
+	t.Len(got.Related, 8)
+	t.NotNil(got.Knowledge)
+	t.NotNil(got.SnackPackMap)
+	t.NotNil(got.Pagination)
+	t.Equal("Pizza Hut", got.Related[0].Text)
+	t.True(got.Related[0].ListGroup)
+
+	_, err = Parse([]byte("[]"))
+	t.Contains(err.Error(), "error parsing response")
+}
+
+func (t *SchemaTestSuite) TestParse_RoundTrip() {
+	buf, err := os.ReadFile(filepath.Join("..", "testdata", "response.json"))
+	t.NoError(err)
+
+	r, err := Parse(buf)
+	t.NoError(err)
+
+	out, err := json.Marshal(r)
+	t.NoError(err)
+
+	got, err := Parse(out)
+	t.NoError(err)
+	t.Equal(r, got)
+	t.Contains(got.PeopleAlsoAsk[1].Answers[0].Value.Text, "Lou Malnati")
+}
+
+func (t *SchemaTestSuite) TestParse_Overflow() {
+	input := `{
+		"general": {"code_version": "2.0", "new_flag": true},
+		"organic": {"link": "https://www.apple.com", "rank": 1, "badge": "Sponsored"},
+		"featured_snippets": {"list": ["Dough", {"text": "Sauce"}]},
+		"images": "wrong",
+		"shopping": [{"title": "MacBook"}]
+	}`
+
+	got, err := Parse([]byte(input))
+	t.NoError(err)
+
+	t.Equal("2.0", got.General.CodeVersion)
+	t.Equal(map[string]json.RawMessage{"new_flag": json.RawMessage("true")}, got.General.Extra)
+	t.Len(got.Organic, 1)
+	t.Equal(map[string]json.RawMessage{"badge": json.RawMessage(`"Sponsored"`)}, got.Organic[0].Extra)
+	t.Equal([]Text{"Dough", "Sauce"}, got.FeaturedSnippets[0].List)
+	t.Nil(got.Images)
+	t.Equal([]string{"images"}, got.Invalid)
+	t.Equal(json.RawMessage(`"wrong"`), got.Extra["images"])
+	t.Equal(json.RawMessage(`[{"title": "MacBook"}]`), got.Extra["shopping"])
+
+	out, err := json.Marshal(got)
+	t.NoError(err)
+	t.Contains(string(out), `"new_flag":true`)
+	t.Contains(string(out), `"badge":"Sponsored"`)
+	t.Contains(string(out), `"images":"wrong"`)
+	t.Contains(string(out), `"shopping":[{"title":"MacBook"}]`)
+}
+
+func (t *SchemaTestSuite) TestParse_InvalidItems() {
+	input := `{"organic": [{"link": "https://a.com", "rank": 1}, {"link": "https://b.com", "rank": "2"}, 1]}`
+
+	got, err := Parse([]byte(input))
+	t.NoError(err)
+
+	t.Len(got.Organic, 2)
+	t.Equal(Organic{Link: "https://a.com", Rank: 1}, got.Organic[0])
+	t.Equal("https://b.com", got.Organic[1].Link)
+	t.Equal(0, got.Organic[1].Rank)
+	t.Equal(map[string]json.RawMessage{"rank": json.RawMessage(`"2"`)}, got.Organic[1].Extra)
+	t.Equal([]string{"organic"}, got.Invalid)
+	t.Equal(json.RawMessage(`[1]`), got.Extra["organic"])
+
+	out, err := json.Marshal(got)
+	t.NoError(err)
+	t.JSONEq(`{"general": {"search_engine": "", "query": "", "results_cnt": 0, "search_time": 0, "language": "", "location": "", "mobile": false, "basic_view": false, "search_type": "", "page_title": "", "code_version": "", "timestamp": "0001-01-01T00:00:00Z"}, "input": {"original_url": ""}, "organic": [{"link": "https://a.com", "title": "", "description": "", "rank": 1, "global_rank": 0}, {"link": "https://b.com", "title": "", "description": "", "rank": "2", "global_rank": 0}, 1]}`, string(out))
+}
+
+func (t *SchemaTestSuite) TestText_UnmarshalJSON() {
+	tt := map[string]struct {
+		input string
+		want  interface{}
+	}{
+		"String": {`"Sauce"`, Text("Sauce")},
+		"Object": {`{"text": "Sauce"}`, Text("Sauce")},
+		"Error":  {`1`, "cannot unmarshal"},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			var got Text
+			err := json.Unmarshal([]byte(test.input), &got)
+			if err != nil {
+				t.Contains(err.Error(), test.want)
+				return
+			}
+			t.Equal(test.want, got)
+		})
+	}
+}
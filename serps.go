@@ -5,6 +5,7 @@
 package luminati
 
 import (
+	"net/url"
 	"strings"
 )
 
@@ -12,8 +13,18 @@ type (
 	// Serps defines the collection to be returned from
 	// the client.
 	Serps struct {
-		Organic  []Organic `json:"serps"`
-		Features []string  `json:"features"`
+		Organic          []Organic         `json:"serps"`
+		Features         []string          `json:"features"`
+		FeaturedSnippets []FeaturedSnippet `json:"featured_snippets,omitempty"`
+		TopStories       []TopStory        `json:"top_stories,omitempty"`
+		Videos           []Video           `json:"videos,omitempty"`
+		Social           []SocialCard      `json:"social,omitempty"`
+		Images           []Image           `json:"images,omitempty"`
+		Related          []Related         `json:"related,omitempty"`
+		Layout           []LayoutBlock     `json:"layout,omitempty"`
+		// CodeVersion is the version of the BrightData parser
+		// that produced the response, see schema.General.
+		CodeVersion string `json:"code_version,omitempty"`
 		//mappedFeatures map[string]string
 	}
 	// Domain are URL specific results returned by
@@ -21,6 +32,17 @@ type (
 	Domain struct {
 		Query   Query     `json:"query"`
 		Results []Organic `json:"results"`
+		// FeaturedSnippet is the featured snippet or answer
+		// box owned by the URL, nil if it owns none.
+		FeaturedSnippet *FeaturedSnippet `json:"featured_snippet,omitempty"`
+		// TopStories, Videos and Social are the carousel items
+		// owned by the URL.
+		TopStories []TopStory   `json:"top_stories,omitempty"`
+		Videos     []Video      `json:"videos,omitempty"`
+		Social     []SocialCard `json:"social,omitempty"`
+		// Images are the image pack results sourced from the
+		// URL.
+		Images []Image `json:"images,omitempty"`
 	}
 	// Query defines the first top level
 	Query struct {
@@ -32,20 +54,114 @@ type (
 	// Organic represents a singular organic SERP
 	// as defined in Serps.
 	Organic struct {
-		Rank        int    `json:"position"`
-		Description string `json:"text"`
-		Link        string `json:"url"`
+		Rank       int `json:"position"`
+		GlobalRank int `json:"global_position,omitempty"`
+		// AbsoluteRank is the position of the result on the
+		// page counting every block above it, such as ads,
+		// snippets and local packs, see Serps.Layout.
+		AbsoluteRank int    `json:"absolute_position,omitempty"`
+		Title        string `json:"title,omitempty"`
+		Description  string `json:"text"`
+		// Link is the cleaned link without the query or
+		// fragment, used for matching.
+		Link string `json:"url"`
+		// OriginalLink is the link as returned by BrightData,
+		// before the query and fragment were removed from
+		// Link.
+		OriginalLink string `json:"original_url,omitempty"`
+		// Query is the parsed query string of OriginalLink.
+		Query url.Values `json:"query,omitempty"`
+		// Highlight is the passage Google highlighted via a
+		// scroll-to-text fragment (#:~:text=) in OriginalLink.
+		Highlight   string      `json:"highlight,omitempty"`
+		DisplayLink string      `json:"display_url,omitempty"`
+		Extensions  []Extension `json:"extensions,omitempty"`
+		Image       string      `json:"image,omitempty"`
+		ImageAlt    string      `json:"image_alt,omitempty"`
+		ImageURL    string      `json:"image_url,omitempty"`
+		Duration    string      `json:"duration,omitempty"`
+		DurationSec int         `json:"duration_sec,omitempty"`
+	}
+	// Extension is a rich snippet attached to an Organic
+	// result, such as a site link, rating, date or fact.
+	Extension struct {
+		Kind   ExtensionKind    `json:"kind"`
+		Text   string           `json:"text,omitempty"`
+		Link   string           `json:"url,omitempty"`
+		Rank   int              `json:"position"`
+		Inline bool             `json:"inline,omitempty"`
+		Key    string           `json:"key,omitempty"`
+		Values []ExtensionValue `json:"values,omitempty"`
+	}
+	// ExtensionValue is a single value of a fact Extension.
+	ExtensionValue struct {
+		Text string `json:"text"`
+		Link string `json:"url,omitempty"`
 	}
+	// ExtensionKind is the type of Extension as reported by
+	// BrightData. Kinds without a constant are kept as is.
+	ExtensionKind string
 )
 
+const (
+	// ExtensionSiteLink is a site link shown below the
+	// result.
+	ExtensionSiteLink ExtensionKind = "site_link"
+	// ExtensionFact is a key and value fact, such as
+	// "Region or state: Campania".
+	ExtensionFact ExtensionKind = "fact"
+	// ExtensionRating is a star rating rich snippet.
+	ExtensionRating ExtensionKind = "rating"
+	// ExtensionDate is a publication date rich snippet.
+	ExtensionDate ExtensionKind = "date"
+)
+
+// ExtensionsOf returns the extensions of the result with the
+// given kind.
+func (o Organic) ExtensionsOf(kind ExtensionKind) []Extension {
+	var extensions []Extension
+	for _, e := range o.Extensions {
+		if e.Kind == kind {
+			extensions = append(extensions, e)
+		}
+	}
+	return extensions
+}
+
+// SiteLinks returns the site link extensions of the result.
+func (o Organic) SiteLinks() []Extension {
+	return o.ExtensionsOf(ExtensionSiteLink)
+}
+
 // CheckURL obtains the highest ranking Serp for a given
 // URL. Features are also obtained.
+//
+// Links are matched with MatchPrefix, so the URL's host must
+// be the same (ignoring scheme and www.) and the link's path
+// must start with the URL's path. Use CheckURLMatch for
+// other modes.
 func (s *Serps) CheckURL(url string) Domain {
+	m, err := NewMatcher(url, MatchPrefix)
+	if err != nil {
+		return Domain{}
+	}
+	return s.checkURL(m)
+}
+
+// CheckURLMatch obtains the highest ranking Serp for links
+// that satisfy the Matcher, see NewMatcher.
+func (s *Serps) CheckURLMatch(m Matcher) Domain {
+	return s.checkURL(m)
+}
+
+// checkURL obtains the highest ranking Serp for links that
+// satisfy the Matcher.
+func (s *Serps) checkURL(m Matcher) Domain {
 	d := Domain{}
 
 	firstFound := true
 	for _, serp := range s.Organic {
-		if !strings.Contains(serp.Link, url) {
+		if !m.Match(serp.Link) {
 			continue
 		}
 		d.Results = append(d.Results, serp)
@@ -56,22 +172,70 @@ func (s *Serps) CheckURL(url string) Domain {
 			Rank:        serp.Rank,
 			Link:        serp.Link,
 			Description: serp.Description,
-			Features:    s.getFeatures(url),
 		}
 		firstFound = false
 	}
 
+	d.Query.Features = s.getFeatures(m)
+
+	for _, fs := range s.FeaturedSnippets {
+		if fs.Link != "" && m.Match(fs.Link) {
+			snippet := fs
+			d.FeaturedSnippet = &snippet
+			break
+		}
+	}
+
+	for _, ts := range s.TopStories {
+		if m.Match(ts.Link) {
+			d.TopStories = append(d.TopStories, ts)
+		}
+	}
+	for _, v := range s.Videos {
+		if m.Match(v.Link) {
+			d.Videos = append(d.Videos, v)
+		}
+	}
+	for _, sc := range s.Social {
+		if m.Match(sc.Link) {
+			d.Social = append(d.Social, sc)
+		}
+	}
+	d.Images = s.ImagesFor(m)
+
 	return d
 }
 
 // getFeatures obtains a comma delimited list of features that
 // the domain ranks for.
-func (s *Serps) getFeatures(url string) string { //nolint
-	features := ""
-	//for key, value := range s.mappedFeatures {
-	//	if strings.Contains(value, url) {
-	//		features += key + ","
-	//	}
-	//}
-	return strings.TrimSuffix(features, ",")
+func (s *Serps) getFeatures(m Matcher) string {
+	return strings.Join(s.ownedFeatures(m), ",")
+}
+
+// ownedFeatures returns the names of the features that
+// contain a link satisfying the Matcher. Social cards count
+// as the feature of their platform, such as "facebook".
+func (s *Serps) ownedFeatures(m Matcher) []string {
+	var features []string
+	add := func(feature, link string) {
+		if link != "" && m.Match(link) && !stringInSlice(feature, features) {
+			features = append(features, feature)
+		}
+	}
+	for _, fs := range s.FeaturedSnippets {
+		add(fs.Feature, fs.Link)
+	}
+	for _, ts := range s.TopStories {
+		add(FeatureTopStories, ts.Link)
+	}
+	for _, v := range s.Videos {
+		add(FeatureVideos, v.Link)
+	}
+	for _, sc := range s.Social {
+		add(sc.Platform, sc.Link)
+	}
+	if len(s.ImagesFor(m)) > 0 && !stringInSlice(FeatureImages, features) {
+		features = append(features, FeatureImages)
+	}
+	return features
 }
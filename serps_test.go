@@ -71,6 +71,35 @@ func (t *LuminatiTestSuite) TestSerps_CheckURL() {
 	}
 }
 
+func (t *LuminatiTestSuite) TestSerps_CheckURLMatch() {
+	s := Serps{Organic: []Organic{
+		{Rank: 1, Link: "https://pineapple.com"},
+		{Rank: 2, Link: "https://apple.com.evil.io"},
+		{Rank: 3, Link: "https://support.apple.com/mac"},
+		{Rank: 4, Link: "https://apple.com/mac"},
+	}}
+
+	tt := map[string]struct {
+		mode MatchMode
+		want int
+	}{
+		"Prefix":    {MatchPrefix, 4},
+		"Domain":    {MatchDomain, 3},
+		"Subdomain": {MatchSubdomain, 3},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			m, err := NewMatcher("https://www.apple.com", test.mode)
+			t.NoError(err)
+			got := s.CheckURLMatch(m)
+			t.Equal(test.want, got.Query.Rank)
+		})
+	}
+
+	t.Equal(Domain{}, s.CheckURL("postgres://user:abc{"))
+}
+
 func (t *LuminatiTestSuite) TestSerps_GetFeatures() {
 	t.T().Skip()
 
@@ -108,7 +137,9 @@ func (t *LuminatiTestSuite) TestSerps_GetFeatures() {
 
 	for name, test := range tt {
 		t.Run(name, func() {
-			got := test.serps.getFeatures(TestURL)
+			m, err := NewMatcher(TestURL, MatchPrefix)
+			t.NoError(err)
+			got := test.serps.getFeatures(m)
 			for _, feature := range test.want {
 				t.Contains(got, feature)
 			}
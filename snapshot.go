@@ -0,0 +1,215 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// SnapshotStore defines the methods used for persisting
+	// and querying Serps over time.
+	SnapshotStore interface {
+		// Save persists a single snapshot.
+		Save(ctx context.Context, s Snapshot) error
+
+		// Snapshots returns all snapshots matching the query,
+		// ordered by date ascending.
+		Snapshots(ctx context.Context, q SnapshotQuery) ([]Snapshot, error)
+
+		// RankHistory returns the rank of a domain for every
+		// snapshot matching the query, ordered by date
+		// ascending.
+		RankHistory(ctx context.Context, domain string, q SnapshotQuery) ([]RankPoint, error)
+	}
+	// Snapshot is a single keyword, country and device lookup
+	// at a point in time, with the full serp data and meta.
+	Snapshot struct {
+		Keyword string    `json:"keyword"`
+		Country string    `json:"country"`
+		Device  string    `json:"device"`
+		Date    time.Time `json:"date"`
+		Serps   Serps     `json:"serps"`
+		Meta    Meta      `json:"meta"`
+	}
+	// SnapshotQuery filters snapshots. Empty fields and zero
+	// times match everything.
+	SnapshotQuery struct {
+		Keyword string
+		Country string
+		Device  string
+		From    time.Time
+		To      time.Time
+	}
+	// RankPoint is the rank of a domain within a single
+	// snapshot. Rank is zero if the domain did not rank.
+	RankPoint struct {
+		Keyword string    `json:"keyword"`
+		Country string    `json:"country"`
+		Device  string    `json:"device"`
+		Date    time.Time `json:"date"`
+		Rank    int       `json:"position"`
+		Link    string    `json:"url"`
+	}
+)
+
+// NewSnapshot creates a Snapshot from the options and data
+// returned by KeywordFinder.JSON. The date is taken from
+// the meta request time.
+func NewSnapshot(o Options, s Serps, m Meta) Snapshot {
+	date := m.RequestTime
+	if date.IsZero() {
+		date = time.Now()
+	}
+	country := o.Country
+	if country == "" {
+		country = DefaultCountry
+	}
+	return Snapshot{
+		Keyword: o.Keyword,
+		Country: country,
+		Device:  o.device(),
+		Date:    date.UTC(),
+		Serps:   s,
+		Meta:    m,
+	}
+}
+
+// Matches determines if the snapshot satisfies the query.
+func (q SnapshotQuery) Matches(s Snapshot) bool {
+	if q.Keyword != "" && q.Keyword != s.Keyword {
+		return false
+	}
+	if q.Country != "" && q.Country != s.Country {
+		return false
+	}
+	if q.Device != "" && q.Device != s.Device {
+		return false
+	}
+	if !q.From.IsZero() && s.Date.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && s.Date.After(q.To) {
+		return false
+	}
+	return true
+}
+
+// FileStore is a SnapshotStore that appends snapshots as
+// JSON lines to a single file.
+type FileStore struct {
+	path string
+	mtx  sync.Mutex
+}
+
+// NewFileStore creates a new FileStore backed by the JSONL
+// file at path. The file is created on the first Save.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Save appends the snapshot to the file.
+func (f *FileStore) Save(_ context.Context, s Snapshot) error {
+	buf, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "error marshalling snapshot")
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	err = os.MkdirAll(filepath.Dir(f.path), os.ModePerm)
+	if err != nil {
+		return errors.Wrap(err, "error creating snapshot directory")
+	}
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint
+	if err != nil {
+		return errors.Wrap(err, "error opening snapshot file")
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(buf, '\n'))
+	if err != nil {
+		return errors.Wrap(err, "error writing snapshot")
+	}
+
+	return nil
+}
+
+// Snapshots reads the file and returns all snapshots
+// matching the query.
+func (f *FileStore) Snapshots(ctx context.Context, q SnapshotQuery) ([]Snapshot, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	file, err := os.Open(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "error opening snapshot file")
+	}
+	defer file.Close()
+
+	var snapshots []Snapshot
+	dec := json.NewDecoder(file)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var s Snapshot
+		err := dec.Decode(&s)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "error decoding snapshot")
+		}
+		if q.Matches(s) {
+			snapshots = append(snapshots, s)
+		}
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Date.Before(snapshots[j].Date)
+	})
+
+	return snapshots, nil
+}
+
+// RankHistory returns the rank of the domain for every
+// snapshot matching the query.
+func (f *FileStore) RankHistory(ctx context.Context, domain string, q SnapshotQuery) ([]RankPoint, error) {
+	snapshots, err := f.Snapshots(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return rankHistory(domain, snapshots), nil
+}
+
+// rankHistory transforms snapshots into rank points for the
+// domain using Serps.CheckURL.
+func rankHistory(domain string, snapshots []Snapshot) []RankPoint {
+	points := make([]RankPoint, 0, len(snapshots))
+	for _, s := range snapshots {
+		d := s.Serps.CheckURL(domain)
+		points = append(points, RankPoint{
+			Keyword: s.Keyword,
+			Country: s.Country,
+			Device:  s.Device,
+			Date:    s.Date,
+			Rank:    d.Query.Rank,
+			Link:    d.Query.Link,
+		})
+	}
+	return points
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func (t *LuminatiTestSuite) TestNewSnapshot() {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := NewSnapshot(Options{Keyword: "macbook", Desktop: true}, Serps{Organic: OrganicTestData}, Meta{RequestTime: now})
+	t.Equal("macbook", got.Keyword)
+	t.Equal(DefaultCountry, got.Country)
+	t.Equal(DeviceDesktop, got.Device)
+	t.Equal(now, got.Date)
+	t.Equal(OrganicTestData, got.Serps.Organic)
+}
+
+func (t *LuminatiTestSuite) TestSnapshotQuery_Matches() {
+	day := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	s := Snapshot{Keyword: "macbook", Country: "us", Device: DeviceMobile, Date: day}
+
+	tt := map[string]struct {
+		input SnapshotQuery
+		want  bool
+	}{
+		"Empty": {
+			SnapshotQuery{},
+			true,
+		},
+		"Keyword": {
+			SnapshotQuery{Keyword: "iphone"},
+			false,
+		},
+		"Country": {
+			SnapshotQuery{Country: "uk"},
+			false,
+		},
+		"Device": {
+			SnapshotQuery{Device: DeviceDesktop},
+			false,
+		},
+		"Before": {
+			SnapshotQuery{From: day.Add(time.Hour)},
+			false,
+		},
+		"After": {
+			SnapshotQuery{To: day.Add(-time.Hour)},
+			false,
+		},
+		"Range": {
+			SnapshotQuery{Keyword: "macbook", From: day.Add(-time.Hour), To: day.Add(time.Hour)},
+			true,
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, test.input.Matches(s))
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestFileStore() {
+	ctx := context.Background()
+	store := NewFileStore(filepath.Join(t.T().TempDir(), "ranks", "snapshots.jsonl"))
+
+	got, err := store.Snapshots(ctx, SnapshotQuery{})
+	t.NoError(err)
+	t.Empty(got)
+
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 2; i >= 0; i-- {
+		err = store.Save(ctx, Snapshot{
+			Keyword: "macbook",
+			Country: "us",
+			Device:  DeviceMobile,
+			Date:    day.AddDate(0, 0, i),
+			Serps:   Serps{Organic: OrganicTestData[i:]},
+		})
+		t.NoError(err)
+	}
+	t.NoError(store.Save(ctx, Snapshot{Keyword: "iphone", Date: day}))
+
+	got, err = store.Snapshots(ctx, SnapshotQuery{Keyword: "macbook"})
+	t.NoError(err)
+	t.Len(got, 3)
+	t.Equal(day, got[0].Date)
+
+	points, err := store.RankHistory(ctx, "bestbuy.com", SnapshotQuery{Keyword: "macbook", To: day.AddDate(0, 0, 1)})
+	t.NoError(err)
+	t.Equal([]RankPoint{
+		{Keyword: "macbook", Country: "us", Device: DeviceMobile, Date: day, Rank: 6, Link: OrganicTestData[5].Link},
+		{Keyword: "macbook", Country: "us", Device: DeviceMobile, Date: day.AddDate(0, 0, 1), Rank: 6, Link: OrganicTestData[5].Link},
+	}, points)
+}
+
+func (t *LuminatiTestSuite) TestFileStore_CacheErrors() {
+	ctx := context.Background()
+	store := NewFileStore(filepath.Join(t.T().TempDir(), "snapshots.jsonl"))
+
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	meta := Meta{CacheKey: "key", RequestTime: day, CacheErrors: []*CacheError{
+		{Op: "get", Key: "key", Err: ErrCacheOpen},
+		{Op: "set", Key: "key", Err: errors.New("connection refused")},
+	}}
+	t.NoError(store.Save(ctx, NewSnapshot(Options{Keyword: "macbook"}, Serps{Organic: OrganicTestData}, meta)))
+
+	got, err := store.Snapshots(ctx, SnapshotQuery{Keyword: "macbook"})
+	t.NoError(err)
+	t.Len(got, 1)
+	t.Len(got[0].Meta.CacheErrors, 2)
+	t.ErrorIs(got[0].Meta.CacheErrors[0], ErrCacheOpen)
+	t.Equal("luminati cache set failed: connection refused", got[0].Meta.CacheErrors[1].Error())
+
+	points, err := store.RankHistory(ctx, "bestbuy.com", SnapshotQuery{Keyword: "macbook"})
+	t.NoError(err)
+	t.Equal([]RankPoint{
+		{Keyword: "macbook", Country: DefaultCountry, Device: DeviceMobile, Date: day, Rank: 6, Link: OrganicTestData[5].Link},
+	}, points)
+}
+
+func (t *LuminatiTestSuite) TestFileStore_Errors() {
+	path := filepath.Join(t.T().TempDir(), "snapshots.jsonl")
+	t.NoError(os.WriteFile(path, []byte("wrong"), 0644))
+
+	store := NewFileStore(path)
+	_, err := store.Snapshots(context.Background(), SnapshotQuery{})
+	t.Contains(err.Error(), "error decoding snapshot")
+
+	_, err = store.RankHistory(context.Background(), "apple.com", SnapshotQuery{})
+	t.Contains(err.Error(), "error decoding snapshot")
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/lacuna-seo/luminati/schema"
+)
+
+// SnippetKind is the layout of a FeaturedSnippet.
+type SnippetKind string
+
+const (
+	// SnippetParagraph is a featured snippet with a single
+	// passage of text.
+	SnippetParagraph SnippetKind = "paragraph"
+	// SnippetList is a featured snippet with an ordered or
+	// unordered list.
+	SnippetList SnippetKind = "list"
+	// SnippetTable is a featured snippet with a table.
+	SnippetTable SnippetKind = "table"
+	// SnippetAnswer is a direct answer box, which may not
+	// have a source URL.
+	SnippetAnswer SnippetKind = "answer"
+)
+
+const (
+	// FeatureFeaturedSnippets is the BrightData block name for
+	// featured snippets.
+	FeatureFeaturedSnippets = "featured_snippets"
+	// FeatureAnswerBox is the BrightData block name for answer
+	// boxes.
+	FeatureAnswerBox = "answer_box"
+)
+
+type (
+	// FeaturedSnippet is a position zero featured snippet or
+	// answer box as defined in Serps.
+	FeaturedSnippet struct {
+		Kind        SnippetKind `json:"kind"`
+		Title       string      `json:"title,omitempty"`
+		Text        string      `json:"text,omitempty"`
+		Items       []string    `json:"items,omitempty"`
+		Table       [][]string  `json:"table,omitempty"`
+		Link        string      `json:"url,omitempty"`
+		DisplayLink string      `json:"display_url,omitempty"`
+		Rank        int         `json:"position"`
+		GlobalRank  int         `json:"global_position,omitempty"`
+		// Feature is the name of the block the snippet was
+		// found in, either FeatureFeaturedSnippets or
+		// FeatureAnswerBox.
+		Feature string `json:"feature"`
+	}
+)
+
+// featuredSnippets transforms the featured snippet and answer
+// box blocks of the response.
+func featuredSnippets(r schema.Response) []FeaturedSnippet {
+	var snippets []FeaturedSnippet
+	for _, b := range r.FeaturedSnippets {
+		snippets = append(snippets, toSnippet(b, FeatureFeaturedSnippets))
+	}
+	for _, b := range r.AnswerBox {
+		snippets = append(snippets, toSnippet(b, FeatureAnswerBox))
+	}
+	return snippets
+}
+
+// toSnippet transforms the response block to a FeaturedSnippet,
+// inferring the kind when BrightData does not specify one.
+func toSnippet(r schema.Snippet, feature string) FeaturedSnippet {
+	s := FeaturedSnippet{
+		Kind:        SnippetKind(r.Type),
+		Title:       r.Title,
+		Text:        firstNonEmpty(r.Description, r.Text, r.Answer),
+		Link:        r.Link,
+		DisplayLink: r.DisplayLink,
+		Rank:        r.Rank,
+		GlobalRank:  r.GlobalRank,
+		Feature:     feature,
+	}
+	for _, item := range r.List {
+		s.Items = append(s.Items, string(item))
+	}
+	for _, row := range r.Table {
+		var cells []string
+		for _, cell := range row {
+			cells = append(cells, string(cell))
+		}
+		s.Table = append(s.Table, cells)
+	}
+	if s.Kind == "" {
+		switch {
+		case feature == FeatureAnswerBox:
+			s.Kind = SnippetAnswer
+		case len(s.Table) > 0:
+			s.Kind = SnippetTable
+		case len(s.Items) > 0:
+			s.Kind = SnippetList
+		default:
+			s.Kind = SnippetParagraph
+		}
+	}
+	return s
+}
+
+// firstNonEmpty returns the first string that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
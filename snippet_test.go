@@ -0,0 +1,75 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"github.com/lacuna-seo/luminati/schema"
+)
+
+func (t *LuminatiTestSuite) TestFeaturedSnippets() {
+	tt := map[string]struct {
+		input string
+		want  []FeaturedSnippet
+	}{
+		"None": {
+			`{"organic": []}`,
+			nil,
+		},
+		"Paragraph": {
+			`{"featured_snippets": [{"title": "Pizza", "description": "Pizza is a dish.", "link": "https://en.wikipedia.org/wiki/Pizza", "rank": 1, "global_rank": 2}]}`,
+			[]FeaturedSnippet{
+				{Kind: SnippetParagraph, Title: "Pizza", Text: "Pizza is a dish.", Link: "https://en.wikipedia.org/wiki/Pizza", Rank: 1, GlobalRank: 2, Feature: FeatureFeaturedSnippets},
+			},
+		},
+		"List Object": {
+			`{"featured_snippets": {"type": "list", "list": ["Dough", {"text": "Sauce"}], "link": "https://pizza.com"}}`,
+			[]FeaturedSnippet{
+				{Kind: SnippetList, Items: []string{"Dough", "Sauce"}, Link: "https://pizza.com", Feature: FeatureFeaturedSnippets},
+			},
+		},
+		"Table": {
+			`{"featured_snippets": [{"table": [["Size", "Slices"], ["Large", {"text": "8"}]]}]}`,
+			[]FeaturedSnippet{
+				{Kind: SnippetTable, Table: [][]string{{"Size", "Slices"}, {"Large", "8"}}, Feature: FeatureFeaturedSnippets},
+			},
+		},
+		"Answer Box": {
+			`{"answer_box": {"answer": "12 inches"}}`,
+			[]FeaturedSnippet{
+				{Kind: SnippetAnswer, Text: "12 inches", Feature: FeatureAnswerBox},
+			},
+		},
+		"Bad Block": {
+			`{"featured_snippets": "wrong", "answer_box": {"answer": "12 inches"}}`,
+			[]FeaturedSnippet{
+				{Kind: SnippetAnswer, Text: "12 inches", Feature: FeatureAnswerBox},
+			},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			r, err := schema.Parse([]byte(test.input))
+			t.NoError(err)
+			t.Equal(test.want, featuredSnippets(r))
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestSerps_CheckURL_FeaturedSnippet() {
+	snippet := FeaturedSnippet{Kind: SnippetParagraph, Link: "https://www.apple.com/macbook-air/", Feature: FeatureFeaturedSnippets}
+	s := Serps{
+		Organic:          OrganicTestData,
+		FeaturedSnippets: []FeaturedSnippet{{Kind: SnippetAnswer, Feature: FeatureAnswerBox}, snippet},
+	}
+
+	got := s.CheckURL(TestURL)
+	t.Equal(&snippet, got.FeaturedSnippet)
+	t.Equal(FeatureFeaturedSnippets, got.Query.Features)
+
+	got = s.CheckURL("https://www.bestbuy.com")
+	t.Nil(got.FeaturedSnippet)
+	t.Equal("", got.Query.Features)
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"context"
+	"encoding/json"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer that
+// creates the spans of a Client, see Client.TracerProvider.
+const TracerName = "github.com/lacuna-seo/luminati"
+
+// HeaderRequestID is the response header BrightData sets to
+// the ID of the request.
+const HeaderRequestID = "X-Brd-Request-Id"
+
+// Span attributes set by the Client.
+const (
+	attrKeyword   = attribute.Key("luminati.keyword")
+	attrCountry   = attribute.Key("luminati.country")
+	attrDevice    = attribute.Key("luminati.device")
+	attrCacheHit  = attribute.Key("luminati.cache_hit")
+	attrCacheKey  = attribute.Key("luminati.cache_key")
+	attrAttempt   = attribute.Key("luminati.attempt")
+	attrAttempts  = attribute.Key("luminati.attempts")
+	attrRequestID = attribute.Key("luminati.request_id")
+	attrDrift     = attribute.Key("luminati.drift")
+	attrBytes     = attribute.Key("luminati.response_bytes")
+	attrStatus    = attribute.Key("http.status_code")
+)
+
+// startSpan starts a span as a child of any span in the
+// context, using the TracerProvider or the global provider
+// if none is set.
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tp := c.TracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error on the span, if any, and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// attributes returns the span attributes of the options.
+func (o *Options) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attrKeyword.String(o.Keyword),
+		attrCountry.String(o.Country),
+		attrDevice.String(o.device()),
+	}
+}
+
+// requestID returns the ID BrightData assigned to the request
+// from a JSON response, or an empty string if there is none.
+func requestID(buf []byte) string {
+	var r struct {
+		Input struct {
+			RequestID string `json:"request_id"`
+		} `json:"input"`
+	}
+	_ = json.Unmarshal(buf, &r) // Reported by toSerps
+	return r.Input.RequestID
+}
@@ -3,6 +3,7 @@ package luminati
 import (
 	"github.com/pkg/errors"
 	"net/url"
+	"strings"
 )
 
 // cleanURL removes and shebangs and query string
@@ -20,6 +21,63 @@ func cleanURL(link string) (string, error) {
 	return uri.String(), nil
 }
 
+// linkQuery returns the parsed query string of a link, nil is
+// returned if the link has no query or could not be parsed.
+func linkQuery(link string) url.Values {
+	uri, err := url.Parse(link)
+	if err != nil || uri.RawQuery == "" {
+		return nil
+	}
+	return uri.Query()
+}
+
+// textFragment returns the passage highlighted by a
+// scroll-to-text fragment (#:~:text=) in a link. Prefix and
+// suffix context is dropped and a range is joined with an
+// ellipsis. An empty string is returned if the link has no
+// text fragment.
+func textFragment(link string) string {
+	uri, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	fragment := uri.EscapedFragment()
+	i := strings.Index(fragment, ":~:")
+	if i == -1 {
+		return ""
+	}
+
+	for _, directive := range strings.Split(fragment[i+3:], "&") {
+		if !strings.HasPrefix(directive, "text=") {
+			continue
+		}
+		var parts []string
+		for _, p := range strings.Split(strings.TrimPrefix(directive, "text="), ",") {
+			if strings.HasSuffix(p, "-") || strings.HasPrefix(p, "-") {
+				continue
+			}
+			text, err := url.PathUnescape(p)
+			if err != nil {
+				text = p
+			}
+			parts = append(parts, text)
+		}
+		return strings.Join(parts, "…")
+	}
+
+	return ""
+}
+
+// cloneValues returns a deep copy of the url.Values.
+func cloneValues(v url.Values) url.Values {
+	c := make(url.Values, len(v))
+	for key, values := range v {
+		c[key] = append([]string(nil), values...)
+	}
+	return c
+}
+
 // stringInSlice checks if a string exists in a slice,
 func stringInSlice(a string, list []string) bool {
 	for _, b := range list {
@@ -4,6 +4,10 @@
 
 package luminati
 
+import (
+	"net/url"
+)
+
 func (t *LuminatiTestSuite) TestCleanURI() {
 	tt := map[string]struct {
 		input string
@@ -60,3 +64,42 @@ func (t *LuminatiTestSuite) TestStringInSlice() {
 		})
 	}
 }
+
+func (t *LuminatiTestSuite) TestLinkQuery() {
+	tt := map[string]struct {
+		input string
+		want  url.Values
+	}{
+		"Error":    {"postgres://user:abc{", nil},
+		"No Query": {"https://google.com", nil},
+		"Query":    {"https://google.com/product?id=1&id=2&ref=x#top", url.Values{"id": {"1", "2"}, "ref": {"x"}}},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, linkQuery(test.input))
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestTextFragment() {
+	tt := map[string]struct {
+		input string
+		want  string
+	}{
+		"Error":         {"postgres://user:abc{", ""},
+		"No Fragment":   {"https://google.com", ""},
+		"Plain":         {"https://google.com#top", ""},
+		"Text":          {"https://google.com#:~:text=best%20pizza", "best pizza"},
+		"Range":         {"https://google.com#:~:text=best,pizza", "best…pizza"},
+		"Context":       {"https://google.com#:~:text=the-,best%20pizza,-in%20town", "best pizza"},
+		"Encoded Comma": {"https://google.com#:~:text=salt%2C%20pepper", "salt, pepper"},
+		"Directives":    {"https://google.com#top:~:foo=bar&text=pizza", "pizza"},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, textFragment(test.input))
+		})
+	}
+}
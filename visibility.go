@@ -0,0 +1,172 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"sort"
+)
+
+type (
+	// CTRCurve is the estimated click-through rate by organic
+	// position for each device. Index 0 is position 1,
+	// positions beyond the end of the curve receive no
+	// clicks.
+	CTRCurve struct {
+		Desktop []float64
+		Mobile  []float64
+	}
+	// VisibilityOptions configures the visibility and
+	// share-of-voice calculation.
+	VisibilityOptions struct {
+		// Curve is the CTR curve used to estimate traffic,
+		// defaults to DefaultCTRCurve.
+		Curve *CTRCurve
+		// Volumes are the optional search volumes keyed by
+		// keyword. Keywords without a volume have a weight
+		// of one.
+		Volumes map[string]float64
+		// Penalties are CTR multipliers keyed by feature name,
+		// applied to an organic result when the feature sits
+		// above it in the Serps.Layout, or to every result
+		// when there is no layout. Defaults to
+		// DefaultFeaturePenalties.
+		Penalties map[string]float64
+	}
+	// DomainVisibility is the estimated-traffic visibility of a
+	// single domain across a keyword portfolio.
+	DomainVisibility struct {
+		Domain       string  `json:"domain"`
+		Visibility   float64 `json:"visibility"`
+		ShareOfVoice float64 `json:"share_of_voice"`
+		Keywords     int     `json:"keywords"`
+		BestRank     int     `json:"best_position"`
+	}
+)
+
+var (
+	// DefaultCTRCurve is the CTR curve used when none is
+	// passed via the VisibilityOptions.
+	DefaultCTRCurve = CTRCurve{
+		Desktop: []float64{0.396, 0.187, 0.102, 0.072, 0.051, 0.044, 0.030, 0.021, 0.019, 0.016},
+		Mobile:  []float64{0.276, 0.158, 0.110, 0.080, 0.064, 0.049, 0.039, 0.033, 0.027, 0.024},
+	}
+	// DefaultFeaturePenalties are the CTR multipliers for
+	// features that typically sit above the organic results
+	// and take clicks away from them.
+	DefaultFeaturePenalties = map[string]float64{
+		"top_ads":         0.75,
+		"snack_pack":      0.7,
+		"snack_pack_map":  0.9,
+		"people_also_ask": 0.9,
+		"images":          0.95,
+	}
+)
+
+// CTR returns the estimated click-through rate for an organic
+// position on the given device.
+func (c CTRCurve) CTR(position int, device string) float64 {
+	curve := c.Mobile
+	if device == DeviceDesktop {
+		curve = c.Desktop
+	}
+	if position < 1 || position > len(curve) {
+		return 0
+	}
+	return curve[position-1]
+}
+
+// ShareOfVoice estimates the traffic each domain receives
+// across the snapshots and returns every competing domain
+// ranked by visibility. A domain's visibility is the sum of
+// its best result's effective CTR per keyword, weighted by
+// search volume, and its share of voice is the fraction of
+// the total visibility of all domains.
+func ShareOfVoice(snapshots []Snapshot, opts VisibilityOptions) []DomainVisibility {
+	curve := DefaultCTRCurve
+	if opts.Curve != nil {
+		curve = *opts.Curve
+	}
+	penalties := opts.Penalties
+	if penalties == nil {
+		penalties = DefaultFeaturePenalties
+	}
+
+	domains := make(map[string]*DomainVisibility)
+	total := 0.0
+
+	for _, s := range snapshots {
+		weight := 1.0
+		if v, ok := opts.Volumes[s.Keyword]; ok {
+			weight = v
+		}
+
+		best := make(map[string]Organic)
+		for _, o := range s.Serps.Organic {
+			d := RegistrableDomain(o.Link)
+			if d == "" {
+				continue
+			}
+			if b, ok := best[d]; !ok || o.Rank < b.Rank {
+				best[d] = o
+			}
+		}
+
+		for d, o := range best {
+			rank := o.Rank
+			dv, ok := domains[d]
+			if !ok {
+				dv = &DomainVisibility{Domain: d, BestRank: rank}
+				domains[d] = dv
+			}
+			v := curve.CTR(rank, s.Device) * s.Serps.ctrMultiplier(o, penalties) * weight
+			dv.Visibility += v
+			dv.Keywords++
+			if rank < dv.BestRank {
+				dv.BestRank = rank
+			}
+			total += v
+		}
+	}
+
+	result := make([]DomainVisibility, 0, len(domains))
+	for _, dv := range domains {
+		if total > 0 {
+			dv.ShareOfVoice = dv.Visibility / total
+		}
+		result = append(result, *dv)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Visibility != result[j].Visibility {
+			return result[i].Visibility > result[j].Visibility
+		}
+		return result[i].Domain < result[j].Domain
+	})
+
+	return result
+}
+
+// ctrMultiplier returns the product of the penalties for the
+// features placed above the organic result. If the result
+// has no absolute position, every feature present on the
+// page is penalised.
+func (s *Serps) ctrMultiplier(o Organic, penalties map[string]float64) float64 {
+	features := s.Features
+	if o.AbsoluteRank > 0 {
+		features = nil
+		for _, b := range s.BlocksAbove(o) {
+			if !stringInSlice(b.Feature, features) {
+				features = append(features, b.Feature)
+			}
+		}
+	}
+	multiplier := 1.0
+	for _, f := range features {
+		if p, ok := penalties[f]; ok {
+			multiplier *= p
+		}
+	}
+	return multiplier
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+func (t *LuminatiTestSuite) TestCTRCurve_CTR() {
+	tt := map[string]struct {
+		position int
+		device   string
+		want     float64
+	}{
+		"Desktop": {1, DeviceDesktop, DefaultCTRCurve.Desktop[0]},
+		"Mobile":  {2, DeviceMobile, DefaultCTRCurve.Mobile[1]},
+		"Zero":    {0, DeviceMobile, 0},
+		"Beyond":  {11, DeviceDesktop, 0},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, DefaultCTRCurve.CTR(test.position, test.device))
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestShareOfVoice() {
+	curve := &CTRCurve{Desktop: []float64{0.5, 0.25}, Mobile: []float64{0.4, 0.2}}
+	snapshots := []Snapshot{
+		{Keyword: "macbook", Device: DeviceDesktop, Serps: Serps{Organic: []Organic{
+			{Rank: 1, Link: "https://www.apple.com/mac/"},
+			{Rank: 2, Link: "https://bestbuy.com/macbook"},
+			{Rank: 3, Link: "https://www.apple.com/macbook-air/"},
+		}}},
+		{Keyword: "iphone", Device: DeviceMobile, Serps: Serps{
+			Features: []string{"top_ads"},
+			Organic: []Organic{
+				{Rank: 1, Link: "https://bestbuy.com/iphone"},
+				{Rank: 2, Link: "https://apple.com/iphone"},
+				{Rank: 3, Link: "postgres://user:abc{"},
+			},
+		}},
+	}
+
+	tt := map[string]struct {
+		opts VisibilityOptions
+		want []DomainVisibility
+	}{
+		"Default": {
+			VisibilityOptions{Curve: curve, Penalties: map[string]float64{}},
+			[]DomainVisibility{
+				{Domain: "apple.com", Visibility: 0.7, ShareOfVoice: 0.7 / 1.35, Keywords: 2, BestRank: 1},
+				{Domain: "bestbuy.com", Visibility: 0.65, ShareOfVoice: 0.65 / 1.35, Keywords: 2, BestRank: 1},
+			},
+		},
+		"Volumes & Penalties": {
+			VisibilityOptions{Curve: curve, Volumes: map[string]float64{"iphone": 10}, Penalties: map[string]float64{"top_ads": 0.5}},
+			[]DomainVisibility{
+				{Domain: "bestbuy.com", Visibility: 2.25, ShareOfVoice: 2.25 / 3.75, Keywords: 2, BestRank: 1},
+				{Domain: "apple.com", Visibility: 1.5, ShareOfVoice: 1.5 / 3.75, Keywords: 2, BestRank: 1},
+			},
+		},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			got := ShareOfVoice(snapshots, test.opts)
+			t.Len(got, len(test.want))
+			for i, want := range test.want {
+				t.Equal(want.Domain, got[i].Domain)
+				t.InDelta(want.Visibility, got[i].Visibility, 0.0001)
+				t.InDelta(want.ShareOfVoice, got[i].ShareOfVoice, 0.0001)
+				t.Equal(want.Keywords, got[i].Keywords)
+				t.Equal(want.BestRank, got[i].BestRank)
+			}
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestShareOfVoice_Defaults() {
+	got := ShareOfVoice([]Snapshot{{Serps: Serps{Organic: OrganicTestData}}}, VisibilityOptions{})
+	t.Equal("apple.com", got[0].Domain)
+	t.Len(got, 6)
+	t.Equal(DefaultCTRCurve.Mobile[0], got[0].Visibility)
+}
+
+func (t *LuminatiTestSuite) TestSerps_CTRMultiplier() {
+	penalties := map[string]float64{"top_ads": 0.5, "people_also_ask": 0.8}
+	s := Serps{
+		Features: []string{"top_ads", "people_also_ask"},
+		Organic:  []Organic{{Rank: 1, AbsoluteRank: 2}, {Rank: 2, AbsoluteRank: 4}, {Rank: 3}},
+		Layout: []LayoutBlock{
+			{Feature: "top_ads", Position: 1},
+			{Feature: FeatureOrganic, Position: 2},
+			{Feature: "people_also_ask", Position: 3},
+			{Feature: FeatureOrganic, Position: 4},
+		},
+	}
+
+	t.InDelta(0.5, s.ctrMultiplier(s.Organic[0], penalties), 0.0001)
+	t.InDelta(0.4, s.ctrMultiplier(s.Organic[1], penalties), 0.0001)
+	t.InDelta(0.4, s.ctrMultiplier(s.Organic[2], penalties), 0.0001)
+}
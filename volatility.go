@@ -0,0 +1,302 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultVolatilityDepth is the number of top organic
+	// results compared when no depth is passed via the
+	// VolatilityOptions.
+	DefaultVolatilityDepth = 10
+	// DefaultRBOPersistence is the rank-biased overlap
+	// persistence used when none is passed via the
+	// VolatilityOptions. Lower values weight the top of the
+	// ranking more heavily.
+	DefaultRBOPersistence = 0.9
+)
+
+type (
+	// VolatilityOptions configures the volatility calculator.
+	VolatilityOptions struct {
+		// Depth is the number of top organic results to
+		// compare, defaults to DefaultVolatilityDepth.
+		Depth int
+		// Persistence is the rank-biased overlap persistence
+		// between 0 and 1, defaults to DefaultRBOPersistence.
+		Persistence float64
+	}
+	// VolatilityMetrics are the stability metrics between two
+	// Serps. RankChange and FeatureChurn are zero when nothing
+	// changed, RBO and Jaccard are one. Index combines all
+	// four into a single score between 0 (stable) and 100.
+	VolatilityMetrics struct {
+		RankChange   float64 `json:"rank_change"`
+		RBO          float64 `json:"rbo"`
+		Jaccard      float64 `json:"jaccard"`
+		FeatureChurn float64 `json:"feature_churn"`
+		Index        float64 `json:"index"`
+	}
+	// VolatilityDay is the average VolatilityMetrics across
+	// all keywords that were compared with their previous
+	// snapshot on a given day.
+	VolatilityDay struct {
+		Date     time.Time `json:"date"`
+		Keywords int       `json:"keywords"`
+		VolatilityMetrics
+	}
+)
+
+// defaults assigns default values to any missing options.
+func (o VolatilityOptions) defaults() VolatilityOptions {
+	if o.Depth <= 0 {
+		o.Depth = DefaultVolatilityDepth
+	}
+	if o.Persistence <= 0 || o.Persistence >= 1 {
+		o.Persistence = DefaultRBOPersistence
+	}
+	return o
+}
+
+// CompareSerps computes the VolatilityMetrics between an
+// older (before) and newer (after) Serps for the same
+// keyword.
+func CompareSerps(before, after Serps, opts VolatilityOptions) VolatilityMetrics {
+	opts = opts.defaults()
+	m := VolatilityMetrics{
+		RankChange:   RankWeightedChange(before.Organic, after.Organic, opts.Depth),
+		RBO:          RankBiasedOverlap(before.Organic, after.Organic, opts.Depth, opts.Persistence),
+		Jaccard:      Jaccard(before.Organic, after.Organic, opts.Depth),
+		FeatureChurn: FeatureChurn(before.Features, after.Features),
+	}
+	m.Index = 100 * (m.RankChange + (1 - m.RBO) + (1 - m.Jaccard) + m.FeatureChurn) / 4
+	return m
+}
+
+// Volatility computes a per-day volatility index for a set
+// of snapshots. Snapshots are grouped by keyword, country
+// and device, and each is compared with the previous
+// snapshot in its group. The metrics are averaged per day
+// and returned ordered by date ascending.
+func Volatility(snapshots []Snapshot, opts VolatilityOptions) []VolatilityDay {
+	type series struct {
+		keyword, country, device string
+	}
+
+	grouped := make(map[series][]Snapshot)
+	for _, s := range snapshots {
+		key := series{s.Keyword, s.Country, s.Device}
+		grouped[key] = append(grouped[key], s)
+	}
+
+	days := make(map[time.Time]*VolatilityDay)
+	for _, group := range grouped {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Date.Before(group[j].Date)
+		})
+		for i := 1; i < len(group); i++ {
+			m := CompareSerps(group[i-1].Serps, group[i].Serps, opts)
+			date := group[i].Date.UTC().Truncate(24 * time.Hour)
+			day, ok := days[date]
+			if !ok {
+				day = &VolatilityDay{Date: date}
+				days[date] = day
+			}
+			day.Keywords++
+			day.RankChange += m.RankChange
+			day.RBO += m.RBO
+			day.Jaccard += m.Jaccard
+			day.FeatureChurn += m.FeatureChurn
+			day.Index += m.Index
+		}
+	}
+
+	result := make([]VolatilityDay, 0, len(days))
+	for _, day := range days {
+		n := float64(day.Keywords)
+		day.RankChange /= n
+		day.RBO /= n
+		day.Jaccard /= n
+		day.FeatureChurn /= n
+		day.Index /= n
+		result = append(result, *day)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date.Before(result[j].Date)
+	})
+
+	return result
+}
+
+// RankWeightedChange returns the normalised position change
+// of the top n URLs between two organic slices. Each URL's
+// absolute rank delta is weighted by the inverse of its best
+// rank, so movement at the top counts for more. URLs outside
+// the top n are treated as ranking at n+1. The result is
+// between 0 (no movement) and 1.
+func RankWeightedChange(before, after []Organic, n int) float64 {
+	a, b := topRanks(before, n), topRanks(after, n)
+
+	var sum, weights float64
+	for _, link := range unionKeys(a, b) {
+		r1, r2 := rankOr(a, link, n+1), rankOr(b, link, n+1)
+		w := 1 / float64(minInt(r1, r2))
+		sum += w * math.Abs(float64(r1-r2))
+		weights += w
+	}
+
+	if weights == 0 {
+		return 0
+	}
+
+	return sum / (weights * float64(n))
+}
+
+// RankBiasedOverlap returns the extrapolated rank-biased
+// overlap (Webber et al.) of the top n URLs of two organic
+// slices with persistence p. Lists are compared to the depth
+// of the shorter list. The result is between 0 (disjoint)
+// and 1 (identical).
+func RankBiasedOverlap(before, after []Organic, n int, p float64) float64 {
+	a, b := topLinks(before, n), topLinks(after, n)
+	k := minInt(len(a), len(b))
+	if k == 0 {
+		if len(a) == len(b) {
+			return 1
+		}
+		return 0
+	}
+
+	seenA := make(map[string]bool, k)
+	seenB := make(map[string]bool, k)
+	overlap := 0
+	sum := 0.0
+	for d := 1; d <= k; d++ {
+		x, y := a[d-1], b[d-1]
+		if x == y {
+			overlap++
+		} else {
+			if seenB[x] {
+				overlap++
+			}
+			if seenA[y] {
+				overlap++
+			}
+		}
+		seenA[x] = true
+		seenB[y] = true
+		sum += float64(overlap) / float64(d) * math.Pow(p, float64(d))
+	}
+
+	return float64(overlap)/float64(k)*math.Pow(p, float64(k)) + (1-p)/p*sum
+}
+
+// Jaccard returns the Jaccard similarity of the top n URLs
+// of two organic slices, between 0 (disjoint) and 1
+// (identical sets).
+func Jaccard(before, after []Organic, n int) float64 {
+	a, b := topRanks(before, n), topRanks(after, n)
+	union := unionKeys(a, b)
+	if len(union) == 0 {
+		return 1
+	}
+	intersection := 0
+	for _, link := range union {
+		_, inA := a[link]
+		_, inB := b[link]
+		if inA && inB {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(union))
+}
+
+// FeatureChurn returns the share of features that appeared
+// or disappeared between two feature lists, between 0 (no
+// churn) and 1.
+func FeatureChurn(before, after []string) float64 {
+	changed := len(stringsDifference(before, after)) + len(stringsDifference(after, before))
+	union := make(map[string]bool)
+	for _, f := range append(append([]string{}, before...), after...) {
+		union[f] = true
+	}
+	if len(union) == 0 {
+		return 0
+	}
+	return float64(changed) / float64(len(union))
+}
+
+// topLinks returns the unique links of the top n organic
+// results ordered by rank.
+func topLinks(organic []Organic, n int) []string {
+	sorted := make([]Organic, len(organic))
+	copy(sorted, organic)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Rank < sorted[j].Rank
+	})
+
+	var links []string
+	seen := make(map[string]bool)
+	for _, o := range sorted {
+		if len(links) == n {
+			break
+		}
+		if seen[o.Link] {
+			continue
+		}
+		seen[o.Link] = true
+		links = append(links, o.Link)
+	}
+	return links
+}
+
+// topRanks returns the position (1-based) of each of the top
+// n links.
+func topRanks(organic []Organic, n int) map[string]int {
+	links := topLinks(organic, n)
+	ranks := make(map[string]int, len(links))
+	for i, link := range links {
+		ranks[link] = i + 1
+	}
+	return ranks
+}
+
+// unionKeys returns the sorted keys that appear in either
+// map.
+func unionKeys(a, b map[string]int) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// rankOr returns the rank of the link or the fallback if the
+// link is not present.
+func rankOr(ranks map[string]int, link string, fallback int) int {
+	if r, ok := ranks[link]; ok {
+		return r
+	}
+	return fallback
+}
+
+// minInt returns the smaller of two integers.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,145 @@
+// Copyright 2020 The Reddico Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package luminati
+
+import (
+	"time"
+)
+
+var (
+	// reversedTestData is OrganicTestData in reverse order.
+	reversedTestData = func() []Organic {
+		var o []Organic
+		for i := len(OrganicTestData) - 1; i >= 0; i-- {
+			o = append(o, Organic{Rank: len(OrganicTestData) - i, Link: OrganicTestData[i].Link})
+		}
+		return o
+	}()
+	// disjointTestData shares no links with OrganicTestData.
+	disjointTestData = []Organic{{Rank: 1, Link: "https://a.com"}, {Rank: 2, Link: "https://b.com"}}
+)
+
+func (t *LuminatiTestSuite) TestRankWeightedChange() {
+	tt := map[string]struct {
+		before []Organic
+		after  []Organic
+		want   float64
+	}{
+		"Identical": {OrganicTestData, OrganicTestData, 0},
+		"Empty":     {nil, nil, 0},
+		"Swap":      {OrganicTestData[:2], []Organic{{Rank: 1, Link: OrganicTestData[1].Link}, {Rank: 2, Link: OrganicTestData[0].Link}}, 0.1},
+		"Disjoint":  {OrganicTestData[:2], disjointTestData, (1*10 + 0.5*9 + 1*10 + 0.5*9) / (3 * 10)},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.InDelta(test.want, RankWeightedChange(test.before, test.after, 10), 0.0001)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestRankBiasedOverlap() {
+	tt := map[string]struct {
+		before []Organic
+		after  []Organic
+		want   float64
+	}{
+		"Identical": {OrganicTestData, OrganicTestData, 1},
+		"Empty":     {nil, nil, 1},
+		"One Empty": {OrganicTestData, nil, 0},
+		"Disjoint":  {OrganicTestData, disjointTestData, 0},
+		"Reversed":  {OrganicTestData, reversedTestData, 0.5116},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.InDelta(test.want, RankBiasedOverlap(test.before, test.after, 10, 0.9), 0.0001)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestJaccard() {
+	tt := map[string]struct {
+		before []Organic
+		after  []Organic
+		want   float64
+	}{
+		"Identical": {OrganicTestData, OrganicTestData, 1},
+		"Empty":     {nil, nil, 1},
+		"Disjoint":  {OrganicTestData, disjointTestData, 0},
+		"Half":      {OrganicTestData[:2], OrganicTestData[1:3], 1.0 / 3},
+		"Depth":     {OrganicTestData[:4], reversedTestData, 0},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.InDelta(test.want, Jaccard(test.before, test.after, 3), 0.0001)
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestFeatureChurn() {
+	tt := map[string]struct {
+		before []string
+		after  []string
+		want   float64
+	}{
+		"Identical": {[]string{"images"}, []string{"images"}, 0},
+		"Empty":     {nil, nil, 0},
+		"Replaced":  {[]string{"images"}, []string{"snack_pack"}, 1},
+		"Half":      {[]string{"images"}, []string{"images", "snack_pack"}, 0.5},
+	}
+
+	for name, test := range tt {
+		t.Run(name, func() {
+			t.Equal(test.want, FeatureChurn(test.before, test.after))
+		})
+	}
+}
+
+func (t *LuminatiTestSuite) TestCompareSerps() {
+	got := CompareSerps(Serps{Organic: OrganicTestData}, Serps{Organic: OrganicTestData}, VolatilityOptions{})
+	t.Equal(VolatilityMetrics{RBO: 1, Jaccard: 1}, got)
+
+	got = CompareSerps(Serps{Organic: OrganicTestData, Features: []string{"images"}}, Serps{Organic: disjointTestData}, VolatilityOptions{})
+	t.Equal(0.0, got.RBO)
+	t.Equal(0.0, got.Jaccard)
+	t.Equal(1.0, got.FeatureChurn)
+	t.Greater(got.Index, 90.0)
+}
+
+func (t *LuminatiTestSuite) TestVolatility() {
+	day := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	snapshots := []Snapshot{
+		{Keyword: "macbook", Date: day.AddDate(0, 0, 2), Serps: Serps{Organic: disjointTestData}},
+		{Keyword: "macbook", Date: day, Serps: Serps{Organic: OrganicTestData}},
+		{Keyword: "macbook", Date: day.AddDate(0, 0, 1), Serps: Serps{Organic: OrganicTestData}},
+		{Keyword: "iphone", Date: day, Serps: Serps{Organic: OrganicTestData}},
+		{Keyword: "iphone", Date: day.AddDate(0, 0, 1), Serps: Serps{Organic: OrganicTestData}},
+	}
+
+	got := Volatility(snapshots, VolatilityOptions{})
+	t.Len(got, 2)
+
+	t.Equal(day.AddDate(0, 0, 1).Truncate(24*time.Hour), got[0].Date)
+	t.Equal(2, got[0].Keywords)
+	t.Equal(0.0, got[0].Index)
+
+	// Every link of OrganicTestData drops out of the top 10
+	// to 11, and a.com and b.com enter at 1 and 2. Nothing
+	// overlaps and the features are unchanged.
+	h10 := 0.0
+	for r := 1; r <= 10; r++ {
+		h10 += 1 / float64(r)
+	}
+	rankChange := (11*h10 - 10 + 1*10 + 0.5*9) / ((h10 + 1.5) * 10)
+	t.Equal(1, got[1].Keywords)
+	t.InDelta(rankChange, got[1].RankChange, 1e-9)
+	t.Equal(0.0, got[1].RBO)
+	t.Equal(0.0, got[1].Jaccard)
+	t.Equal(0.0, got[1].FeatureChurn)
+	t.InDelta(100*(rankChange+1+1)/4, got[1].Index, 1e-9)
+	t.InDelta(70.726, got[1].Index, 0.001)
+}